@@ -84,14 +84,24 @@ func getGenesis(genesisPath string) (*core.Genesis, error) {
 
 // SetBorConfig sets bor config
 func SetBorConfig(ctx *cli.Context, cfg *eth.Config) {
-	cfg.HeimdallURL = ctx.GlobalString(HeimdallURLFlag.Name)
+	cfg.HeimdallURL = heimdallURLs(ctx)
 	cfg.WithoutHeimdall = ctx.GlobalBool(WithoutHeimdallFlag.Name)
-	cfg.HeimdallgRPCAddress = ctx.GlobalString(HeimdallgRPCAddressFlag.Name)
+	cfg.HeimdallgRPCAddress = SplitAndTrim(ctx.GlobalString(HeimdallgRPCAddressFlag.Name))
 	cfg.RunHeimdall = ctx.GlobalBool(RunHeimdallFlag.Name)
 	cfg.RunHeimdallArgs = ctx.GlobalString(RunHeimdallArgsFlag.Name)
 	cfg.UseHeimdallApp = ctx.GlobalBool(UseHeimdallAppFlag.Name)
 }
 
+// heimdallURLs returns the Heimdall URLs of the flags. The default URL is only
+// used without a gRPC address, which takes precedence over it.
+func heimdallURLs(ctx *cli.Context) []string {
+	if !ctx.GlobalIsSet(HeimdallURLFlag.Name) && ctx.GlobalString(HeimdallgRPCAddressFlag.Name) != "" {
+		return nil
+	}
+
+	return SplitAndTrim(ctx.GlobalString(HeimdallURLFlag.Name))
+}
+
 // CreateBorEthereum Creates bor ethereum object from eth.Config
 func CreateBorEthereum(cfg *eth.Config) *eth.Ethereum {
 	workspace, err := ioutil.TempDir("", "bor-command-node-")
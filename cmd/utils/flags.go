@@ -2043,9 +2043,9 @@ func MakeChain(ctx *cli.Context, stack *node.Node) (chain *core.BlockChain, chai
 	} else if config.Bor != nil {
 		ethereum = CreateBorEthereum(&eth.Config{
 			Genesis:             genesis,
-			HeimdallURL:         ctx.GlobalString(HeimdallURLFlag.Name),
+			HeimdallURL:         heimdallURLs(ctx),
 			WithoutHeimdall:     ctx.GlobalBool(WithoutHeimdallFlag.Name),
-			HeimdallgRPCAddress: ctx.GlobalString(HeimdallgRPCAddressFlag.Name),
+			HeimdallgRPCAddress: SplitAndTrim(ctx.GlobalString(HeimdallgRPCAddressFlag.Name)),
 			RunHeimdall:         ctx.GlobalBool(RunHeimdallFlag.Name),
 			RunHeimdallArgs:     ctx.GlobalString(RunHeimdallArgsFlag.Name),
 			UseHeimdallApp:      ctx.GlobalBool(UseHeimdallAppFlag.Name),
@@ -6,6 +6,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -100,3 +101,10 @@ func SetAttributes(span trace.Span, kvs ...attribute.KeyValue) {
 		span.SetAttributes(kvs...)
 	}
 }
+
+func RecordError(span trace.Span, err error) {
+	if span != nil && err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
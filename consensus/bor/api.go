@@ -1,16 +1,23 @@
 package bor
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
 	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -23,6 +30,17 @@ import (
 var (
 	// MaxCheckpointLength is the maximum number of blocks that can be requested for constructing a checkpoint root hash
 	MaxCheckpointLength = uint64(math.Pow(2, 15))
+
+	// MaxSpanHistory is the maximum number of spans that can be requested at once
+	MaxSpanHistory = uint64(64)
+
+	// MaxStateSyncBlockRange is the maximum number of blocks that can be searched for state-sync events at once
+	MaxStateSyncBlockRange = uint64(10000)
+
+	// MaxPerformanceBlockRange is the maximum number of blocks that can be checked for validator performance at once
+	MaxPerformanceBlockRange = uint64(10000)
+
+	errNoHeimdallClient = errors.New("no heimdall client available to fetch spans from")
 )
 
 // API is a user facing RPC API to allow controlling the signer and voting
@@ -271,6 +289,306 @@ func (api *API) GetCurrentValidators() ([]*valset.Validator, error) {
 	return snap.ValidatorSet.Validators, nil
 }
 
+// SpanInfo describes a span along with the block which committed it to the
+// validator set contract
+type SpanInfo struct {
+	ID         uint64 `json:"id"`
+	StartBlock uint64 `json:"startBlock"`
+	EndBlock   uint64 `json:"endBlock"`
+
+	// CommitBlock and CommitBlockHash are nil for the genesis span and for
+	// spans which aren't committed yet
+	CommitBlock     *uint64      `json:"commitBlock"`
+	CommitBlockHash *common.Hash `json:"commitBlockHash"`
+
+	Validators []*valset.Validator `json:"validators"`
+	Producers  []*valset.Validator `json:"producers"`
+}
+
+// GetSpan returns the span with the given id, or the current span if none is
+// requested. Heimdall only knows the spans up to the one following the current
+// span, so later ones are rejected rather than retried until heimdall has them.
+func (api *API) GetSpan(ctx context.Context, spanID *uint64) (*SpanInfo, error) {
+	currentSpanID, err := api.currentSpanID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if spanID == nil {
+		spanID = &currentSpanID
+	}
+
+	if *spanID > currentSpanID+1 {
+		return nil, fmt.Errorf("span %d is after the next span %d", *spanID, currentSpanID+1)
+	}
+
+	return api.getSpanInfo(ctx, *spanID)
+}
+
+// GetSpanHistory returns the last count spans, up to and including the current span
+func (api *API) GetSpanHistory(ctx context.Context, count uint64) ([]*SpanInfo, error) {
+	if count > MaxSpanHistory {
+		return nil, fmt.Errorf("requested %d spans, exceeding the max allowed history of %d spans", count, MaxSpanHistory)
+	}
+
+	currentSpanID, err := api.currentSpanID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if count > currentSpanID+1 {
+		count = currentSpanID + 1
+	}
+
+	spans := make([]*SpanInfo, 0, count)
+
+	for id := currentSpanID + 1 - count; id <= currentSpanID; id++ {
+		info, err := api.getSpanInfo(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		spans = append(spans, info)
+	}
+
+	return spans, nil
+}
+
+// currentSpanID returns the id of the span committed in the validator set
+// contract at the current head
+func (api *API) currentSpanID(ctx context.Context) (uint64, error) {
+	header := api.chain.CurrentHeader()
+	if header == nil {
+		return 0, errUnknownBlock
+	}
+
+	currentSpan, err := api.bor.spanner.GetCurrentSpan(ctx, header.Hash())
+	if err != nil {
+		return 0, err
+	}
+
+	return currentSpan.ID, nil
+}
+
+func (api *API) getSpanInfo(ctx context.Context, spanID uint64) (*SpanInfo, error) {
+	if api.bor.HeimdallClient == nil {
+		return nil, errNoHeimdallClient
+	}
+
+	heimdallSpan, err := api.bor.HeimdallClient.Span(ctx, spanID)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &SpanInfo{
+		ID:         heimdallSpan.ID,
+		StartBlock: heimdallSpan.StartBlock,
+		EndBlock:   heimdallSpan.EndBlock,
+		Validators: heimdallSpan.ValidatorSet.Validators,
+		Producers:  make([]*valset.Validator, 0, len(heimdallSpan.SelectedProducers)),
+	}
+
+	for i := range heimdallSpan.SelectedProducers {
+		info.Producers = append(info.Producers, &heimdallSpan.SelectedProducers[i])
+	}
+
+	// A span is committed in the first block of the last sprint of the previous
+	// span, see needToCommitSpan
+	if spanID > 0 && heimdallSpan.StartBlock > 0 {
+		sprint := api.bor.config.CalculateSprint(heimdallSpan.StartBlock - 1)
+
+		if heimdallSpan.StartBlock > sprint {
+			commitBlock := heimdallSpan.StartBlock - sprint
+
+			if header := api.chain.GetHeaderByNumber(commitBlock); header != nil {
+				hash := header.Hash()
+
+				info.CommitBlock = &commitBlock
+				info.CommitBlockHash = &hash
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// StateSyncEvent is a state-sync event along with the block which committed it
+type StateSyncEvent struct {
+	ID          uint64         `json:"id"`
+	Contract    common.Address `json:"contract"`
+	Data        hexutil.Bytes  `json:"data"`
+	TxHash      common.Hash    `json:"txHash"`
+	Time        time.Time      `json:"time"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+}
+
+// GetStateSyncEvents returns the state-sync events committed in the canonical
+// blocks from start to end, ordered by state id
+func (api *API) GetStateSyncEvents(start uint64, end uint64) ([]*StateSyncEvent, error) {
+	currentHeaderNumber := api.chain.CurrentHeader().Number.Uint64()
+
+	if start > end || end > currentHeaderNumber {
+		return nil, &valset.InvalidStartEndBlockError{Start: start, End: end, CurrentHeader: currentHeaderNumber}
+	}
+
+	if end-start+1 > MaxStateSyncBlockRange {
+		return nil, fmt.Errorf("block range %d-%d exceeds the max allowed range of %d blocks", start, end, MaxStateSyncBlockRange)
+	}
+
+	events := make([]*StateSyncEvent, 0)
+
+	for number := start; number <= end; number++ {
+		// states are only committed in the first block of a sprint
+		if !IsSprintStart(number, api.bor.config.CalculateSprint(number)) {
+			continue
+		}
+
+		header := api.chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, errUnknownBlock
+		}
+
+		hash := header.Hash()
+
+		for _, stateSyncData := range rawdb.ReadBorStateSyncData(api.bor.db, hash, number) {
+			data, err := hex.DecodeString(stateSyncData.Data)
+			if err != nil {
+				return nil, err
+			}
+
+			events = append(events, &StateSyncEvent{
+				ID:          stateSyncData.ID,
+				Contract:    stateSyncData.Contract,
+				Data:        data,
+				TxHash:      stateSyncData.TxHash,
+				Time:        time.Unix(int64(stateSyncData.Time), 0).UTC(),
+				BlockNumber: number,
+				BlockHash:   hash,
+			})
+		}
+	}
+
+	return events, nil
+}
+
+// GetValidatorPerformance returns who was the primary producer and who actually
+// produced each of the canonical blocks from start to end, aggregated per
+// validator, sprint and span.
+func (api *API) GetValidatorPerformance(ctx context.Context, start uint64, end uint64) (*ValidatorPerformance, error) {
+	// the genesis block has no producer
+	if start == 0 {
+		start = 1
+	}
+
+	currentHeaderNumber := api.chain.CurrentHeader().Number.Uint64()
+
+	if start > end || end > currentHeaderNumber {
+		return nil, &valset.InvalidStartEndBlockError{Start: start, End: end, CurrentHeader: currentHeaderNumber}
+	}
+
+	if end-start+1 > MaxPerformanceBlockRange {
+		return nil, fmt.Errorf("block range %d-%d exceeds the max allowed range of %d blocks", start, end, MaxPerformanceBlockRange)
+	}
+
+	parent := api.chain.GetHeaderByNumber(start - 1)
+	if parent == nil {
+		return nil, errUnknownBlock
+	}
+
+	snap, err := api.bor.snapshot(api.chain, start-1, parent.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		slots   = make([]slot, 0, end-start+1)
+		pending []*types.Header
+	)
+
+	for number := start; number <= end; number++ {
+		header := api.chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, errUnknownBlock
+		}
+
+		signer, err := ecrecover(header, api.bor.signatures, api.bor.config)
+		if err != nil {
+			return nil, err
+		}
+
+		succession, err := snap.GetSignerSuccessionNumber(signer)
+		if err != nil {
+			return nil, err
+		}
+
+		slots = append(slots, slot{
+			number:     number,
+			primary:    snap.ValidatorSet.GetProposer().Address,
+			producer:   signer,
+			succession: succession,
+			wiggle:     wiggleDelay(number, succession, api.bor.config),
+		})
+
+		// the proposer only changes at the end of a sprint, so the snapshot is
+		// only moved forward once per sprint
+		pending = append(pending, header)
+
+		if (number+1)%api.bor.config.CalculateSprint(number) == 0 && number < end {
+			if snap, err = snap.apply(pending); err != nil {
+				return nil, err
+			}
+
+			pending = nil
+		}
+	}
+
+	spans, err := api.getSpansInRange(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return summarizePerformance(slots, spans, api.bor.config), nil
+}
+
+// getSpansInRange returns the spans covering the blocks from start to end, in
+// ascending order. No spans are returned if heimdall isn't available.
+func (api *API) getSpansInRange(ctx context.Context, start uint64, end uint64) ([]*span.Span, error) {
+	if api.bor.HeimdallClient == nil || api.bor.spanner == nil {
+		return nil, nil
+	}
+
+	header := api.chain.GetHeaderByNumber(end)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+
+	// the span committed at the end block may start after it
+	lastSpan, err := api.bor.spanner.GetCurrentSpan(ctx, header.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	var spans []*span.Span
+
+	for id, count := lastSpan.ID, uint64(0); count < MaxSpanHistory; id, count = id-1, count+1 {
+		heimdallSpan, err := api.bor.HeimdallClient.Span(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if heimdallSpan.StartBlock <= end {
+			spans = append([]*span.Span{&heimdallSpan.Span}, spans...)
+		}
+
+		if heimdallSpan.StartBlock <= start || id == 0 {
+			break
+		}
+	}
+
+	return spans, nil
+}
+
 // GetRootHash returns the merkle root of the start to end block headers
 func (api *API) GetRootHash(start uint64, end uint64) (string, error) {
 	if err := api.initializeRootHashCache(); err != nil {
@@ -313,7 +631,21 @@ func (api *API) GetRootHash(start uint64, end uint64) (string, error) {
 	wg.Wait()
 	close(concurrent)
 
-	headers := make([][32]byte, nextPowerOfTwo(length))
+	rootHash, err := ComputeRootHash(blockHeaders)
+	if err != nil {
+		return "", err
+	}
+
+	root := hex.EncodeToString(rootHash)
+	api.rootHashCache.Add(key, root)
+
+	return root, nil
+}
+
+// ComputeRootHash computes the checkpoint root hash, which is the merkle root
+// over the given consecutive block headers.
+func ComputeRootHash(blockHeaders []*types.Header) ([]byte, error) {
+	headers := make([][32]byte, nextPowerOfTwo(uint64(len(blockHeaders))))
 
 	for i := 0; i < len(blockHeaders); i++ {
 		blockHeader := blockHeaders[i]
@@ -332,13 +664,10 @@ func (api *API) GetRootHash(start uint64, end uint64) (string, error) {
 
 	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
 	if err := tree.Generate(convert(headers), sha3.NewLegacyKeccak256()); err != nil {
-		return "", err
+		return nil, err
 	}
 
-	root := hex.EncodeToString(tree.Root().Hash)
-	api.rootHashCache.Add(key, root)
-
-	return root, nil
+	return tree.Root().Hash, nil
 }
 
 func (api *API) initializeRootHashCache() error {
@@ -0,0 +1,184 @@
+package bor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/tests/bor/mocks"
+)
+
+// fakeHeaderChain is a chain of empty headers up to its head
+type fakeHeaderChain struct {
+	headers []*types.Header
+}
+
+func newFakeHeaderChain(head uint64) *fakeHeaderChain {
+	chain := &fakeHeaderChain{}
+
+	for i := uint64(0); i <= head; i++ {
+		chain.headers = append(chain.headers, &types.Header{Number: new(big.Int).SetUint64(i)})
+	}
+
+	return chain
+}
+
+func (c *fakeHeaderChain) Config() *params.ChainConfig { return params.TestChainConfig }
+
+func (c *fakeHeaderChain) CurrentHeader() *types.Header { return c.headers[len(c.headers)-1] }
+
+func (c *fakeHeaderChain) GetHeader(_ common.Hash, number uint64) *types.Header {
+	return c.GetHeaderByNumber(number)
+}
+
+func (c *fakeHeaderChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number >= uint64(len(c.headers)) {
+		return nil
+	}
+
+	return c.headers[number]
+}
+
+func (c *fakeHeaderChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, header := range c.headers {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+
+	return nil
+}
+
+func (c *fakeHeaderChain) GetTd(common.Hash, uint64) *big.Int { return nil }
+
+func testHeimdallSpan(id, startBlock, endBlock uint64) *span.HeimdallSpan {
+	validators := []*valset.Validator{
+		{ID: 1, Address: common.HexToAddress("0x1"), VotingPower: 10},
+		{ID: 2, Address: common.HexToAddress("0x2"), VotingPower: 20},
+	}
+
+	return &span.HeimdallSpan{
+		Span:              span.Span{ID: id, StartBlock: startBlock, EndBlock: endBlock},
+		ValidatorSet:      valset.ValidatorSet{Validators: validators},
+		SelectedProducers: []valset.Validator{*validators[id%2]},
+		ChainID:           "15001",
+	}
+}
+
+func TestGetSpan(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chain := newFakeHeaderChain(300)
+
+	spanner := NewMockSpanner(ctrl)
+	spanner.EXPECT().GetCurrentSpan(gomock.Any(), chain.CurrentHeader().Hash()).Return(&span.Span{ID: 1, StartBlock: 256, EndBlock: 6655}, nil).AnyTimes()
+
+	heimdallClient := mocks.NewMockIHeimdallClient(ctrl)
+	heimdallClient.EXPECT().Span(gomock.Any(), uint64(0)).Return(testHeimdallSpan(0, 0, 255), nil).AnyTimes()
+	heimdallClient.EXPECT().Span(gomock.Any(), uint64(1)).Return(testHeimdallSpan(1, 256, 6655), nil).AnyTimes()
+	heimdallClient.EXPECT().Span(gomock.Any(), uint64(2)).Return(testHeimdallSpan(2, 6656, 13055), nil).AnyTimes()
+
+	api := &API{
+		chain: chain,
+		bor: &Bor{
+			config:         &params.BorConfig{Sprint: map[string]uint64{"0": 64}},
+			spanner:        spanner,
+			HeimdallClient: heimdallClient,
+		},
+	}
+
+	current, err := api.GetSpan(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), current.ID)
+	require.Len(t, current.Validators, 2)
+	require.Len(t, current.Producers, 1)
+	require.Equal(t, common.HexToAddress("0x2"), current.Producers[0].Address)
+	require.Equal(t, int64(20), current.Producers[0].VotingPower)
+
+	// span 1 is committed in the first block of the last sprint of span 0
+	require.NotNil(t, current.CommitBlock)
+	require.Equal(t, uint64(192), *current.CommitBlock)
+	require.Equal(t, chain.GetHeaderByNumber(192).Hash(), *current.CommitBlockHash)
+
+	// the genesis span isn't committed by any block
+	id := uint64(0)
+	genesis, err := api.GetSpan(context.Background(), &id)
+	require.NoError(t, err)
+	require.Nil(t, genesis.CommitBlock)
+
+	// the next span isn't committed yet
+	id = 2
+	next, err := api.GetSpan(context.Background(), &id)
+	require.NoError(t, err)
+	require.Nil(t, next.CommitBlock)
+
+	// the spans after the next one aren't fetched from heimdall
+	id = 3
+	_, err = api.GetSpan(context.Background(), &id)
+	require.Error(t, err)
+
+	history, err := api.GetSpanHistory(context.Background(), 5)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, uint64(0), history[0].ID)
+	require.Equal(t, uint64(1), history[1].ID)
+
+	_, err = api.GetSpanHistory(context.Background(), MaxSpanHistory+1)
+	require.Error(t, err)
+}
+
+func TestGetStateSyncEvents(t *testing.T) {
+	t.Parallel()
+
+	chain := newFakeHeaderChain(100)
+	db := rawdb.NewMemoryDatabase()
+
+	api := &API{
+		chain: chain,
+		bor: &Bor{
+			config: &params.BorConfig{Sprint: map[string]uint64{"0": 16}},
+			db:     db,
+		},
+	}
+
+	for i, number := range []uint64{16, 48} {
+		header := chain.GetHeaderByNumber(number)
+
+		rawdb.WriteBorStateSyncData(db, header.Hash(), number, []*types.StateSyncData{
+			{ID: uint64(2*i + 1), Contract: common.HexToAddress("0x1001"), Data: "0102", Time: 1_000_000},
+			{ID: uint64(2*i + 2), Contract: common.HexToAddress("0x1001"), Data: "0304", Time: 1_000_001},
+		})
+	}
+
+	events, err := api.GetStateSyncEvents(0, 100)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	for i, event := range events {
+		require.Equal(t, uint64(i+1), event.ID)
+	}
+
+	require.Equal(t, uint64(48), events[2].BlockNumber)
+	require.Equal(t, chain.GetHeaderByNumber(48).Hash(), events[2].BlockHash)
+	require.Equal(t, []byte{0x3, 0x4}, []byte(events[3].Data))
+	require.Equal(t, int64(1_000_001), events[3].Time.Unix())
+
+	events, err = api.GetStateSyncEvents(17, 47)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	_, err = api.GetStateSyncEvents(50, 101)
+	require.Error(t, err)
+}
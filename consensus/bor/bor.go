@@ -16,6 +16,7 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/crypto/sha3"
@@ -220,6 +221,7 @@ type Bor struct {
 	signatures *lru.ARCCache // Signatures of recent blocks to speed up mining
 
 	authorizedSigner atomic.Pointer[signer] // Ethereum address and sign function of the signing key
+	signLock         sync.Mutex             // Serializes the signing of protected signers
 
 	ethAPI                 api.Caller
 	spanner                Spanner
@@ -234,8 +236,9 @@ type Bor struct {
 }
 
 type signer struct {
-	signer common.Address // Ethereum address of the signing key
-	signFn SignerFn       // Signer function to authorize hashes with
+	signer    common.Address // Ethereum address of the signing key
+	signFn    SignerFn       // Signer function to authorize hashes with
+	protected bool           // Whether to sign only once per height, see AuthorizeRemote
 }
 
 // New creates a Matic Bor consensus engine.
@@ -273,8 +276,8 @@ func New(
 	}
 
 	c.authorizedSigner.Store(&signer{
-		common.Address{},
-		func(_ accounts.Account, _ string, i []byte) ([]byte, error) {
+		signer: common.Address{},
+		signFn: func(_ accounts.Account, _ string, i []byte) ([]byte, error) {
 			// return an error to prevent panics
 			return nil, &UnauthorizedSignerError{0, common.Address{}.Bytes()}
 		},
@@ -792,7 +795,7 @@ func (c *Bor) Finalize(chain consensus.ChainHeaderReader, header *types.Header,
 	headerNumber := header.Number.Uint64()
 
 	if IsSprintStart(headerNumber, c.config.CalculateSprint(headerNumber)) {
-		ctx := context.Background()
+		ctx := tracing.WithTracer(context.Background(), otel.GetTracerProvider().Tracer("BorFinalize"))
 		cx := statefull.ChainContext{Chain: chain, Bor: c}
 		// check and commit span
 		if err := c.checkAndCommitSpan(ctx, state, header, cx); err != nil {
@@ -819,7 +822,9 @@ func (c *Bor) Finalize(chain consensus.ChainHeaderReader, header *types.Header,
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = types.CalcUncleHash(nil)
 
-	// Set state sync data to blockchain
+	// Set state sync data to the state of the block, to be stored with it, and to blockchain
+	state.SetStateSyncData(stateSyncData)
+
 	bc := chain.(*core.BlockChain)
 	bc.SetStateSync(stateSyncData)
 }
@@ -883,11 +888,8 @@ func (c *Bor) FinalizeAndAssemble(ctx context.Context, chain consensus.ChainHead
 		}
 
 		if c.HeimdallClient != nil {
-			tracing.Exec(finalizeCtx, "", "bor.checkAndCommitSpan", func(ctx context.Context, span trace.Span) {
-				// commit states
-				stateSyncData, err = c.CommitStates(finalizeCtx, state, header, cx)
-			})
-
+			// commit states
+			stateSyncData, err = c.CommitStates(finalizeCtx, state, header, cx)
 			if err != nil {
 				log.Error("Error while committing states", "error", err)
 				return nil, err
@@ -916,6 +918,8 @@ func (c *Bor) FinalizeAndAssemble(ctx context.Context, chain consensus.ChainHead
 	block := types.NewBlock(header, txs, nil, receipts, new(trie.Trie))
 
 	// set state sync
+	state.SetStateSyncData(stateSyncData)
+
 	bc := chain.(core.BorStateSyncer)
 	bc.SetStateSync(stateSyncData)
 
@@ -931,6 +935,32 @@ func (c *Bor) FinalizeAndAssemble(ctx context.Context, chain consensus.ChainHead
 	return block, nil
 }
 
+// IsPrimaryProducer returns whether the authorized signer is the primary, that
+// is in-turn, producer of the block with the given header.
+func (c *Bor) IsPrimaryProducer(chain consensus.ChainHeaderReader, header *types.Header) (bool, error) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return false, nil
+	}
+
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return false, err
+	}
+
+	signer := c.authorizedSigner.Load().signer
+	if !snap.ValidatorSet.HasAddress(signer) {
+		return false, nil
+	}
+
+	succession, err := snap.GetSignerSuccessionNumber(signer)
+	if err != nil {
+		return false, err
+	}
+
+	return succession == 0, nil
+}
+
 // Authorize injects a private key into the consensus engine to mint new blocks
 // with.
 func (c *Bor) Authorize(currentSigner common.Address, signFn SignerFn) {
@@ -940,6 +970,23 @@ func (c *Bor) Authorize(currentSigner common.Address, signFn SignerFn) {
 	})
 }
 
+// AuthorizedSigner returns the address of the signing key, the zero address if
+// the node isn't authorized to seal blocks.
+func (c *Bor) AuthorizedSigner() common.Address {
+	return c.authorizedSigner.Load().signer
+}
+
+// AuthorizeRemote injects a remote signer into the consensus engine to mint new
+// blocks with. Blocks are only signed once their slot is reached, and never
+// twice at the same height, see signProtected.
+func (c *Bor) AuthorizeRemote(currentSigner common.Address, signFn SignerFn) {
+	c.authorizedSigner.Store(&signer{
+		signer:    currentSigner,
+		signFn:    signFn,
+		protected: true,
+	})
+}
+
 // Seal implements consensus.Engine, attempting to create a sealed block using
 // the local signing credentials.
 func (c *Bor) Seal(ctx context.Context, chain consensus.ChainHeaderReader, block *types.Block, results chan<- *types.Block, stop <-chan struct{}) error {
@@ -988,12 +1035,15 @@ func (c *Bor) Seal(ctx context.Context, chain consensus.ChainHeaderReader, block
 	// Sweet, the protocol permits us to sign the block, wait for our time
 	delay := time.Unix(int64(header.Time), 0).Sub(time.Now()) // nolint: gosimple
 	// wiggle was already accounted for in header.Time, this is just for logging
-	wiggle := time.Duration(successionNumber) * time.Duration(c.config.CalculateBackupMultiplier(number)) * time.Second
+	wiggle := wiggleDelay(number, successionNumber, c.config)
 
-	// Sign all the things!
-	err = Sign(currentSigner.signFn, currentSigner.signer, header, c.config)
-	if err != nil {
-		return err
+	// Sign all the things! Protected signers only sign the block which is
+	// eventually sealed, once the slot is reached
+	if !currentSigner.protected {
+		err = Sign(currentSigner.signFn, currentSigner.signer, header, c.config)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Wait until sealing is terminated or delay timeout.
@@ -1005,6 +1055,15 @@ func (c *Bor) Seal(ctx context.Context, chain consensus.ChainHeaderReader, block
 			log.Debug("Discarding sealing operation for block", "number", number)
 			return
 		case <-time.After(delay):
+			if currentSigner.protected {
+				if err := c.signProtected(&currentSigner, header); err != nil {
+					log.Error("Failed to sign block", "number", number, "err", err)
+					tracing.EndSpan(sealSpan)
+
+					return
+				}
+			}
+
 			if wiggle > 0 {
 				log.Info(
 					"Sealing out-of-turn",
@@ -1180,7 +1239,13 @@ func (c *Bor) CommitStates(
 	state *state.StateDB,
 	header *types.Header,
 	chain statefull.ChainContext,
-) ([]*types.StateSyncData, error) {
+) (_ []*types.StateSyncData, err error) {
+	ctx, span := tracing.StartSpan(ctx, "bor.CommitStates")
+	defer func() {
+		tracing.RecordError(span, err)
+		tracing.EndSpan(span)
+	}()
+
 	fetchStart := time.Now()
 	number := header.Number.Uint64()
 
@@ -1197,10 +1262,21 @@ func (c *Bor) CommitStates(
 		"fromID", lastStateID+1,
 		"to", to.Format(time.RFC3339))
 
-	eventRecords, err := c.HeimdallClient.StateSyncEvents(ctx, lastStateID+1, to.Unix())
-	if err != nil {
-		log.Error("Error occurred when fetching state sync events", "stateID", lastStateID+1, "error", err)
-	}
+	var eventRecords []*clerk.EventRecordWithTime
+
+	tracing.Exec(ctx, "", "bor.StateSyncEvents", func(_ context.Context, fetchSpan trace.Span) {
+		eventRecords, err = c.HeimdallClient.StateSyncEvents(ctx, lastStateID+1, to.Unix())
+		if err != nil {
+			log.Error("Error occurred when fetching state sync events", "stateID", lastStateID+1, "error", err)
+		}
+
+		tracing.SetAttributes(
+			fetchSpan,
+			attribute.Int("from id", int(lastStateID+1)),
+			attribute.Int("number of records", len(eventRecords)),
+			attribute.Bool("error", err != nil),
+		)
+	})
 
 	if c.config.OverrideStateSyncRecords != nil {
 		if val, ok := c.config.OverrideStateSyncRecords[strconv.FormatUint(number, 10)]; ok {
@@ -1231,6 +1307,7 @@ func (c *Bor) CommitStates(
 			Contract: eventRecord.Contract,
 			Data:     hex.EncodeToString(eventRecord.Data),
 			TxHash:   eventRecord.TxHash,
+			Time:     uint64(eventRecord.Time.Unix()),
 		}
 
 		stateSyncs = append(stateSyncs, &stateData)
@@ -1250,6 +1327,15 @@ func (c *Bor) CommitStates(
 
 	processTime := time.Since(processStart)
 
+	tracing.SetAttributes(
+		span,
+		attribute.Int("number", int(number)),
+		attribute.Int("number of state syncs", len(stateSyncs)),
+		attribute.Int("gas used", totalGas),
+		attribute.Int("fetch time", int(fetchTime.Milliseconds())),
+		attribute.Int("process time", int(processTime.Milliseconds())),
+	)
+
 	log.Info("StateSyncData", "gas", totalGas, "number", number, "lastStateID", lastStateID, "total records", len(eventRecords), "fetch time", int(fetchTime.Milliseconds()), "process time", int(processTime.Milliseconds()))
 
 	return stateSyncs, nil
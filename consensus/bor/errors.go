@@ -79,6 +79,23 @@ func (e *UnauthorizedSignerError) Error() string {
 	)
 }
 
+// DoubleSignError is returned if a signer is asked to sign a block at a height
+// where it already signed a different block, or below it.
+type DoubleSignError struct {
+	Number     uint64
+	LastSigned uint64
+	Signer     []byte
+}
+
+func (e *DoubleSignError) Error() string {
+	return fmt.Sprintf(
+		"Refusing to sign block %d with signer 0x%x, which already signed block %d",
+		e.Number,
+		e.Signer,
+		e.LastSigned,
+	)
+}
+
 // WrongDifficultyError is returned if the difficulty of a block doesn't match the
 // turn of the signer.
 type WrongDifficultyError struct {
@@ -22,6 +22,8 @@ const (
 	spanRequest            requestType = "span"
 	checkpointRequest      requestType = "checkpoint"
 	checkpointCountRequest requestType = "checkpoint-count"
+	milestoneRequest       requestType = "milestone"
+	milestoneCountRequest  requestType = "milestone-count"
 )
 
 func withRequestType(ctx context.Context, reqType requestType) context.Context {
@@ -63,6 +65,20 @@ var (
 			},
 			timer: metrics.NewRegisteredTimer("client/requests/checkpointcount/duration", nil),
 		},
+		milestoneRequest: {
+			request: map[bool]metrics.Meter{
+				true:  metrics.NewRegisteredMeter("client/requests/milestone/valid", nil),
+				false: metrics.NewRegisteredMeter("client/requests/milestone/invalid", nil),
+			},
+			timer: metrics.NewRegisteredTimer("client/requests/milestone/duration", nil),
+		},
+		milestoneCountRequest: {
+			request: map[bool]metrics.Meter{
+				true:  metrics.NewRegisteredMeter("client/requests/milestonecount/valid", nil),
+				false: metrics.NewRegisteredMeter("client/requests/milestonecount/invalid", nil),
+			},
+			timer: metrics.NewRegisteredTimer("client/requests/milestonecount/duration", nil),
+		},
 	}
 )
 
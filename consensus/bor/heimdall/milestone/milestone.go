@@ -0,0 +1,37 @@
+package milestone
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrNotSupported is returned by heimdall clients which can't fetch milestones
+	ErrNotSupported = errors.New("milestones are not supported by this heimdall client")
+)
+
+// Milestone defines a response object type of bor milestone
+type Milestone struct {
+	Proposer   common.Address `json:"proposer"`
+	StartBlock *big.Int       `json:"start_block"`
+	EndBlock   *big.Int       `json:"end_block"`
+	Hash       common.Hash    `json:"hash"`
+	BorChainID string         `json:"bor_chain_id"`
+	Timestamp  uint64         `json:"timestamp"`
+}
+
+type MilestoneResponse struct {
+	Height string    `json:"height"`
+	Result Milestone `json:"result"`
+}
+
+type MilestoneCount struct {
+	Count int64 `json:"count"`
+}
+
+type MilestoneCountResponse struct {
+	Height string         `json:"height"`
+	Result MilestoneCount `json:"result"`
+}
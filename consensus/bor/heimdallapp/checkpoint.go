@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
 	"github.com/ethereum/go-ethereum/log"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -36,6 +37,16 @@ func (h *HeimdallAppClient) FetchCheckpoint(_ context.Context, number int64) (*c
 	return toBorCheckpoint(res), nil
 }
 
+// FetchMilestone is not supported, as the embedded heimdall app has no milestone module
+func (h *HeimdallAppClient) FetchMilestone(_ context.Context) (*milestone.Milestone, error) {
+	return nil, milestone.ErrNotSupported
+}
+
+// FetchMilestoneCount is not supported, as the embedded heimdall app has no milestone module
+func (h *HeimdallAppClient) FetchMilestoneCount(_ context.Context) (int64, error) {
+	return 0, milestone.ErrNotSupported
+}
+
 func (h *HeimdallAppClient) NewContext() types.Context {
 	return h.hApp.NewContext(true, abci.Header{Height: h.hApp.LastBlockHeight()})
 }
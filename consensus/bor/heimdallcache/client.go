@@ -0,0 +1,211 @@
+package heimdallcache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// HeimdallCacheClient wraps another heimdall client and persists every span,
+// state-sync event record and checkpoint it returns in the node database. Repeat
+// queries are served locally, so that a resync does not depend on heimdall.
+type HeimdallCacheClient struct {
+	client bor.IHeimdallClient
+	db     ethdb.Database
+
+	eventsLock sync.Mutex // Serialises updates of the cached event records and their coverage
+}
+
+// NewHeimdallCacheClient creates a caching heimdall client backed by the given database.
+func NewHeimdallCacheClient(client bor.IHeimdallClient, db ethdb.Database) *HeimdallCacheClient {
+	return &HeimdallCacheClient{
+		client: client,
+		db:     db,
+	}
+}
+
+// Span returns the span with the given id, fetching it from heimdall only if it
+// isn't already cached. Spans are immutable once proposed on heimdall.
+func (h *HeimdallCacheClient) Span(ctx context.Context, spanID uint64) (*span.HeimdallSpan, error) {
+	if data := rawdb.ReadHeimdallSpan(h.db, spanID); len(data) > 0 {
+		heimdallSpan := new(span.HeimdallSpan)
+
+		err := json.Unmarshal(data, heimdallSpan)
+		if err == nil {
+			markHit(spanRequest)
+
+			return heimdallSpan, nil
+		}
+
+		log.Warn("Failed to decode cached heimdall span", "spanID", spanID, "err", err)
+	}
+
+	markMiss(spanRequest)
+
+	heimdallSpan, err := h.client.Span(ctx, spanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err := json.Marshal(heimdallSpan); err == nil {
+		rawdb.WriteHeimdallSpan(h.db, spanID, data)
+	}
+
+	return heimdallSpan, nil
+}
+
+// StateSyncEvents returns the event records with an id of at least fromID and a
+// record time before to. The query is answered from the cache if the cached
+// records are known to be complete up to the requested time.
+func (h *HeimdallCacheClient) StateSyncEvents(ctx context.Context, fromID uint64, to int64) ([]*clerk.EventRecordWithTime, error) {
+	h.eventsLock.Lock()
+	defer h.eventsLock.Unlock()
+
+	if eventRecords, ok := h.cachedStateSyncEvents(fromID, to); ok {
+		markHit(stateSyncRequest)
+
+		return eventRecords, nil
+	}
+
+	markMiss(stateSyncRequest)
+
+	eventRecords, err := h.client.StateSyncEvents(ctx, fromID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := h.db.NewBatch()
+
+	// lastID is the highest id heimdall knows of with a record time before to
+	var lastID uint64
+	if fromID > 0 {
+		lastID = fromID - 1
+	}
+
+	for _, eventRecord := range eventRecords {
+		data, err := json.Marshal(eventRecord)
+		if err != nil {
+			return eventRecords, nil
+		}
+
+		rawdb.WriteHeimdallEventRecord(batch, eventRecord.ID, data)
+
+		if eventRecord.ID > lastID {
+			lastID = eventRecord.ID
+		}
+	}
+
+	// Records are sequential and ordered in time, so no record after lastID can
+	// be older than to. Only move the coverage forward, never backwards.
+	coveredID, coveredTo, ok := rawdb.ReadHeimdallEventCoverage(h.db)
+	if !ok || (lastID >= coveredID && to >= coveredTo) {
+		rawdb.WriteHeimdallEventCoverage(batch, lastID, to)
+	}
+
+	if err := batch.Write(); err != nil {
+		log.Warn("Failed to cache heimdall event records", "fromID", fromID, "to", to, "err", err)
+	}
+
+	return eventRecords, nil
+}
+
+// cachedStateSyncEvents tries to serve a state-sync query from the cache. It
+// returns false if the cache cannot prove the result to be complete.
+func (h *HeimdallCacheClient) cachedStateSyncEvents(fromID uint64, to int64) ([]*clerk.EventRecordWithTime, bool) {
+	coveredID, coveredTo, ok := rawdb.ReadHeimdallEventCoverage(h.db)
+	if !ok || to > coveredTo {
+		return nil, false
+	}
+
+	eventRecords := make([]*clerk.EventRecordWithTime, 0)
+
+	for id := fromID; id <= coveredID; id++ {
+		data := rawdb.ReadHeimdallEventRecord(h.db, id)
+		if len(data) == 0 {
+			// there's a gap in the cache, let heimdall fill it
+			return nil, false
+		}
+
+		eventRecord := new(clerk.EventRecordWithTime)
+		if err := json.Unmarshal(data, eventRecord); err != nil {
+			log.Warn("Failed to decode cached heimdall event record", "stateID", id, "err", err)
+			return nil, false
+		}
+
+		if eventRecord.Time.Unix() >= to {
+			break
+		}
+
+		eventRecords = append(eventRecords, eventRecord)
+	}
+
+	return eventRecords, true
+}
+
+// FetchCheckpoint returns the checkpoint with the given number. The latest
+// checkpoint (number -1) changes over time and is always fetched from heimdall.
+func (h *HeimdallCacheClient) FetchCheckpoint(ctx context.Context, number int64) (*checkpoint.Checkpoint, error) {
+	if number >= 0 {
+		if data := rawdb.ReadHeimdallCheckpoint(h.db, uint64(number)); len(data) > 0 {
+			cp := new(checkpoint.Checkpoint)
+
+			err := json.Unmarshal(data, cp)
+			if err == nil {
+				markHit(checkpointRequest)
+
+				return cp, nil
+			}
+
+			log.Warn("Failed to decode cached heimdall checkpoint", "number", number, "err", err)
+		}
+	}
+
+	markMiss(checkpointRequest)
+
+	cp, err := h.client.FetchCheckpoint(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if number >= 0 {
+		if data, err := json.Marshal(cp); err == nil {
+			rawdb.WriteHeimdallCheckpoint(h.db, uint64(number), data)
+		}
+	}
+
+	return cp, nil
+}
+
+// FetchCheckpointCount always queries heimdall, as the count keeps growing.
+func (h *HeimdallCacheClient) FetchCheckpointCount(ctx context.Context) (int64, error) {
+	return h.client.FetchCheckpointCount(ctx)
+}
+
+// FetchMilestone always queries heimdall, as the latest milestone changes every few blocks.
+func (h *HeimdallCacheClient) FetchMilestone(ctx context.Context) (*milestone.Milestone, error) {
+	return h.client.FetchMilestone(ctx)
+}
+
+// FetchMilestoneCount always queries heimdall, as the count keeps growing.
+func (h *HeimdallCacheClient) FetchMilestoneCount(ctx context.Context) (int64, error) {
+	return h.client.FetchMilestoneCount(ctx)
+}
+
+// Unwrap returns the underlying heimdall client.
+func (h *HeimdallCacheClient) Unwrap() bor.IHeimdallClient {
+	return h.client
+}
+
+// Close closes the underlying heimdall client. The database is owned by the node.
+func (h *HeimdallCacheClient) Close() {
+	h.client.Close()
+}
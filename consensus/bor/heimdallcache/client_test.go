@@ -0,0 +1,136 @@
+package heimdallcache
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/tests/bor/mocks"
+)
+
+func newEventRecord(id uint64, recordTime time.Time) *clerk.EventRecordWithTime {
+	return &clerk.EventRecordWithTime{
+		EventRecord: clerk.EventRecord{
+			ID:       id,
+			Contract: common.HexToAddress("0x1"),
+			Data:     []byte{byte(id)},
+			ChainID:  "15001",
+		},
+		Time: recordTime.UTC(),
+	}
+}
+
+func TestCacheSpan(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := rawdb.NewMemoryDatabase()
+	heimdall := mocks.NewMockIHeimdallClient(ctrl)
+
+	heimdallSpan := &span.HeimdallSpan{
+		Span:    span.Span{ID: 3, StartBlock: 6656, EndBlock: 13055},
+		ChainID: "15001",
+	}
+
+	// heimdall must only be queried once, even across client instances
+	heimdall.EXPECT().Span(gomock.Any(), uint64(3)).Return(heimdallSpan, nil).Times(1)
+
+	res, err := NewHeimdallCacheClient(heimdall, db).Span(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, heimdallSpan, res)
+
+	res, err = NewHeimdallCacheClient(heimdall, db).Span(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, heimdallSpan, res)
+}
+
+func TestCacheCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := rawdb.NewMemoryDatabase()
+	heimdall := mocks.NewMockIHeimdallClient(ctrl)
+	client := NewHeimdallCacheClient(heimdall, db)
+
+	cp := &checkpoint.Checkpoint{
+		StartBlock: big.NewInt(0),
+		EndBlock:   big.NewInt(255),
+		RootHash:   common.HexToHash("0x2"),
+		BorChainID: "15001",
+	}
+
+	heimdall.EXPECT().FetchCheckpoint(gomock.Any(), int64(1)).Return(cp, nil).Times(1)
+
+	// the latest checkpoint is never cached
+	heimdall.EXPECT().FetchCheckpoint(gomock.Any(), int64(-1)).Return(cp, nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		res, err := client.FetchCheckpoint(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, cp, res)
+
+		_, err = client.FetchCheckpoint(context.Background(), -1)
+		require.NoError(t, err)
+	}
+}
+
+func TestCacheStateSyncEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := rawdb.NewMemoryDatabase()
+	heimdall := mocks.NewMockIHeimdallClient(ctrl)
+	client := NewHeimdallCacheClient(heimdall, db)
+
+	base := time.Unix(1_000_000, 0)
+	events := []*clerk.EventRecordWithTime{
+		newEventRecord(1, base),
+		newEventRecord(2, base.Add(10*time.Second)),
+		newEventRecord(3, base.Add(20*time.Second)),
+	}
+
+	to := base.Add(30 * time.Second).Unix()
+
+	heimdall.EXPECT().StateSyncEvents(gomock.Any(), uint64(1), to).Return(events, nil).Times(1)
+
+	res, err := client.StateSyncEvents(context.Background(), 1, to)
+	require.NoError(t, err)
+	require.Equal(t, events, res)
+
+	// the same query, and any older sub-range, is served from the cache
+	res, err = client.StateSyncEvents(context.Background(), 1, to)
+	require.NoError(t, err)
+	require.Equal(t, events, res)
+
+	res, err = client.StateSyncEvents(context.Background(), 2, base.Add(15*time.Second).Unix())
+	require.NoError(t, err)
+	require.Equal(t, events[1:2], res)
+
+	// a query beyond the covered time must go to heimdall
+	later := base.Add(60 * time.Second).Unix()
+	heimdall.EXPECT().StateSyncEvents(gomock.Any(), uint64(4), later).Return([]*clerk.EventRecordWithTime{}, nil).Times(1)
+
+	res, err = client.StateSyncEvents(context.Background(), 4, later)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// and afterwards an empty range is known to be empty as well
+	res, err = client.StateSyncEvents(context.Background(), 4, later)
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
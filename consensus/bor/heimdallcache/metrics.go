@@ -0,0 +1,38 @@
+package heimdallcache
+
+import (
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+type requestType string
+
+const (
+	stateSyncRequest  requestType = "statesync"
+	spanRequest       requestType = "span"
+	checkpointRequest requestType = "checkpoint"
+)
+
+var (
+	cacheMeters = map[requestType]map[bool]metrics.Meter{ // map[requestType]map[isHit]metrics.Meter
+		stateSyncRequest: {
+			true:  metrics.NewRegisteredMeter("client/cache/statesync/hit", nil),
+			false: metrics.NewRegisteredMeter("client/cache/statesync/miss", nil),
+		},
+		spanRequest: {
+			true:  metrics.NewRegisteredMeter("client/cache/span/hit", nil),
+			false: metrics.NewRegisteredMeter("client/cache/span/miss", nil),
+		},
+		checkpointRequest: {
+			true:  metrics.NewRegisteredMeter("client/cache/checkpoint/hit", nil),
+			false: metrics.NewRegisteredMeter("client/cache/checkpoint/miss", nil),
+		},
+	}
+)
+
+func markHit(reqType requestType) {
+	cacheMeters[reqType][true].Mark(1)
+}
+
+func markMiss(reqType requestType) {
+	cacheMeters[reqType][false].Mark(1)
+}
@@ -0,0 +1,353 @@
+package heimdallfailover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	// ErrShutdownDetected is returned if a shutdown was detected
+	ErrShutdownDetected = errors.New("shutdown detected")
+
+	// ErrNoEndpoints is returned if the client was created without any endpoint
+	ErrNoEndpoints = errors.New("no heimdall endpoints configured")
+
+	// ErrSpanMismatch is returned if two heimdall endpoints disagree on a span
+	ErrSpanMismatch = errors.New("heimdall endpoints returned different spans")
+)
+
+const (
+	// attemptTimeout bounds a single request to one endpoint, so that a stuck
+	// endpoint doesn't keep the client from failing over to the next one.
+	attemptTimeout = 15 * time.Second
+
+	// retryCall is the delay between two rounds over all endpoints
+	retryCall = 5 * time.Second
+
+	// latencyWeight is the weight of a new sample in the latency moving average
+	latencyWeight = 0.2
+)
+
+// Endpoint is a single named heimdall client, either HTTP or gRPC.
+type Endpoint struct {
+	Name   string
+	Client bor.IHeimdallClient
+}
+
+// EndpointHealth is a snapshot of the health statistics of a single endpoint.
+type EndpointHealth struct {
+	Name                string
+	Requests            uint64
+	Errors              uint64
+	ConsecutiveFailures uint64
+	Latency             time.Duration // moving average of successful requests
+	LastError           string
+	LastSuccess         time.Time
+}
+
+// ErrorRate returns the share of failed requests to the endpoint.
+func (h EndpointHealth) ErrorRate() float64 {
+	if h.Requests == 0 {
+		return 0
+	}
+
+	return float64(h.Errors) / float64(h.Requests)
+}
+
+// Healthy reports whether the last request to the endpoint succeeded.
+func (h EndpointHealth) Healthy() bool {
+	return h.ConsecutiveFailures == 0
+}
+
+type endpoint struct {
+	Endpoint
+
+	index  int // position in the configured list, used to prefer earlier endpoints
+	lock   sync.RWMutex
+	health EndpointHealth
+}
+
+func (e *endpoint) record(start time.Time, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.health.Requests++
+
+	if err != nil {
+		e.health.Errors++
+		e.health.ConsecutiveFailures++
+		e.health.LastError = err.Error()
+
+		return
+	}
+
+	latency := time.Since(start)
+	if e.health.Latency == 0 {
+		e.health.Latency = latency
+	} else {
+		e.health.Latency = time.Duration((1-latencyWeight)*float64(e.health.Latency) + latencyWeight*float64(latency))
+	}
+
+	e.health.ConsecutiveFailures = 0
+	e.health.LastSuccess = time.Now()
+}
+
+func (e *endpoint) snapshot() EndpointHealth {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	return e.health
+}
+
+// FailoverHeimdallClient is a heimdall client backed by several endpoints. Every
+// request is sent to the healthiest endpoint first and fails over to the next
+// one on error, until one of them answers or the client is closed.
+type FailoverHeimdallClient struct {
+	endpoints       []*endpoint
+	crossCheckSpans bool
+	closeCh         chan struct{}
+}
+
+// NewFailoverHeimdallClient creates a heimdall client failing over between the
+// given endpoints, in order of preference. If crossCheckSpans is set, every
+// span is verified against a second endpoint before it is returned.
+func NewFailoverHeimdallClient(endpoints []Endpoint, crossCheckSpans bool) (*FailoverHeimdallClient, error) {
+	if len(endpoints) == 0 {
+		return nil, ErrNoEndpoints
+	}
+
+	h := &FailoverHeimdallClient{
+		endpoints:       make([]*endpoint, 0, len(endpoints)),
+		crossCheckSpans: crossCheckSpans,
+		closeCh:         make(chan struct{}),
+	}
+
+	for i, e := range endpoints {
+		h.endpoints = append(h.endpoints, &endpoint{
+			Endpoint: e,
+			index:    i,
+			health:   EndpointHealth{Name: e.Name},
+		})
+	}
+
+	return h, nil
+}
+
+// Health returns the health statistics of all endpoints, in configured order.
+func (h *FailoverHeimdallClient) Health() []EndpointHealth {
+	health := make([]EndpointHealth, 0, len(h.endpoints))
+	for _, e := range h.endpoints {
+		health = append(health, e.snapshot())
+	}
+
+	return health
+}
+
+// ordered returns the endpoints sorted by health. Endpoints with fewer
+// consecutive failures come first, ties keep the configured order.
+func (h *FailoverHeimdallClient) ordered() []*endpoint {
+	type entry struct {
+		e        *endpoint
+		failures uint64
+	}
+
+	entries := make([]entry, 0, len(h.endpoints))
+	for _, e := range h.endpoints {
+		entries = append(entries, entry{e, e.snapshot().ConsecutiveFailures})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].failures < entries[j].failures
+	})
+
+	ordered := make([]*endpoint, 0, len(entries))
+	for _, entry := range entries {
+		ordered = append(ordered, entry.e)
+	}
+
+	return ordered
+}
+
+// call runs fn against the endpoints in order of health, retrying all of them
+// until one succeeds, the context is cancelled or the client is closed. It
+// returns the endpoint which answered along with the result. Endpoints which
+// don't support milestones are skipped, and milestone.ErrNotSupported is
+// returned right away if none of them does.
+func call[T any](ctx context.Context, h *FailoverHeimdallClient, fn func(context.Context, bor.IHeimdallClient) (T, error)) (T, *endpoint, error) {
+	var zero T
+
+	ticker := time.NewTicker(retryCall)
+	defer ticker.Stop()
+
+	for attempt := 1; ; attempt++ {
+		unsupported := 0
+
+		for _, e := range h.ordered() {
+			result, err := tryEndpoint(ctx, e, fn)
+			if err == nil {
+				return result, e, nil
+			}
+
+			if errors.Is(err, milestone.ErrNotSupported) {
+				unsupported++
+				continue
+			}
+
+			log.Warn("an error while trying fetching from Heimdall endpoint", "endpoint", e.Name, "attempt", attempt, "error", err)
+
+			select {
+			case <-ctx.Done():
+				return zero, nil, ctx.Err()
+			case <-h.closeCh:
+				return zero, nil, ErrShutdownDetected
+			default:
+			}
+		}
+
+		if unsupported == len(h.endpoints) {
+			return zero, nil, milestone.ErrNotSupported
+		}
+
+		log.Info("Retrying again in 5 seconds to fetch data from Heimdall", "endpoints", len(h.endpoints), "attempt", attempt)
+
+		select {
+		case <-ctx.Done():
+			log.Debug("Shutdown detected, terminating request by context.Done")
+
+			return zero, nil, ctx.Err()
+		case <-h.closeCh:
+			log.Debug("Shutdown detected, terminating request by closing")
+
+			return zero, nil, ErrShutdownDetected
+		case <-ticker.C:
+		}
+	}
+}
+
+// tryEndpoint runs fn against a single endpoint and records its health. An
+// endpoint not supporting the request isn't unhealthy, so it isn't recorded.
+func tryEndpoint[T any](ctx context.Context, e *endpoint, fn func(context.Context, bor.IHeimdallClient) (T, error)) (T, error) {
+	ctx, cancel := context.WithTimeout(ctx, attemptTimeout)
+	defer cancel()
+
+	start := time.Now()
+	result, err := fn(ctx, e.Client)
+
+	if !errors.Is(err, milestone.ErrNotSupported) {
+		e.record(start, err)
+	}
+
+	return result, err
+}
+
+func (h *FailoverHeimdallClient) StateSyncEvents(ctx context.Context, fromID uint64, to int64) ([]*clerk.EventRecordWithTime, error) {
+	eventRecords, _, err := call(ctx, h, func(ctx context.Context, client bor.IHeimdallClient) ([]*clerk.EventRecordWithTime, error) {
+		return client.StateSyncEvents(ctx, fromID, to)
+	})
+
+	return eventRecords, err
+}
+
+func (h *FailoverHeimdallClient) Span(ctx context.Context, spanID uint64) (*span.HeimdallSpan, error) {
+	heimdallSpan, answered, err := call(ctx, h, func(ctx context.Context, client bor.IHeimdallClient) (*span.HeimdallSpan, error) {
+		return client.Span(ctx, spanID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if h.crossCheckSpans {
+		if err := h.crossCheckSpan(ctx, heimdallSpan, answered); err != nil {
+			return nil, err
+		}
+	}
+
+	return heimdallSpan, nil
+}
+
+// crossCheckSpan fetches the same span from a second endpoint and compares it.
+// If no other endpoint answers, the span is accepted with a warning.
+func (h *FailoverHeimdallClient) crossCheckSpan(ctx context.Context, heimdallSpan *span.HeimdallSpan, answered *endpoint) error {
+	for _, e := range h.ordered() {
+		if e == answered {
+			continue
+		}
+
+		other, err := tryEndpoint(ctx, e, func(ctx context.Context, client bor.IHeimdallClient) (*span.HeimdallSpan, error) {
+			return client.Span(ctx, heimdallSpan.ID)
+		})
+		if err != nil {
+			log.Warn("Failed to cross-check span with Heimdall endpoint", "spanID", heimdallSpan.ID, "endpoint", e.Name, "error", err)
+			continue
+		}
+
+		if !reflect.DeepEqual(heimdallSpan, other) {
+			log.Error("Heimdall endpoints disagree on span", "spanID", heimdallSpan.ID, "endpoint", answered.Name, "other", e.Name)
+
+			return fmt.Errorf("%w: span %d differs between %s and %s", ErrSpanMismatch, heimdallSpan.ID, answered.Name, e.Name)
+		}
+
+		return nil
+	}
+
+	log.Warn("Could not cross-check span, no other Heimdall endpoint available", "spanID", heimdallSpan.ID, "endpoint", answered.Name)
+
+	return nil
+}
+
+// FetchCheckpoint fetches the checkpoint from heimdall
+func (h *FailoverHeimdallClient) FetchCheckpoint(ctx context.Context, number int64) (*checkpoint.Checkpoint, error) {
+	cp, _, err := call(ctx, h, func(ctx context.Context, client bor.IHeimdallClient) (*checkpoint.Checkpoint, error) {
+		return client.FetchCheckpoint(ctx, number)
+	})
+
+	return cp, err
+}
+
+// FetchCheckpointCount fetches the checkpoint count from heimdall
+func (h *FailoverHeimdallClient) FetchCheckpointCount(ctx context.Context) (int64, error) {
+	count, _, err := call(ctx, h, func(ctx context.Context, client bor.IHeimdallClient) (int64, error) {
+		return client.FetchCheckpointCount(ctx)
+	})
+
+	return count, err
+}
+
+// FetchMilestone fetches the latest milestone from heimdall
+func (h *FailoverHeimdallClient) FetchMilestone(ctx context.Context) (*milestone.Milestone, error) {
+	m, _, err := call(ctx, h, func(ctx context.Context, client bor.IHeimdallClient) (*milestone.Milestone, error) {
+		return client.FetchMilestone(ctx)
+	})
+
+	return m, err
+}
+
+// FetchMilestoneCount fetches the milestone count from heimdall
+func (h *FailoverHeimdallClient) FetchMilestoneCount(ctx context.Context) (int64, error) {
+	count, _, err := call(ctx, h, func(ctx context.Context, client bor.IHeimdallClient) (int64, error) {
+		return client.FetchMilestoneCount(ctx)
+	})
+
+	return count, err
+}
+
+// Close sends a signal to stop the running process and closes all endpoints
+func (h *FailoverHeimdallClient) Close() {
+	close(h.closeCh)
+
+	for _, e := range h.endpoints {
+		e.Client.Close()
+	}
+}
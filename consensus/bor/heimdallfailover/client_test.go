@@ -0,0 +1,147 @@
+package heimdallfailover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/tests/bor/mocks"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+func newTestClient(t *testing.T, crossCheckSpans bool) (*FailoverHeimdallClient, *mocks.MockIHeimdallClient, *mocks.MockIHeimdallClient) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	primary := mocks.NewMockIHeimdallClient(ctrl)
+	secondary := mocks.NewMockIHeimdallClient(ctrl)
+
+	client, err := NewFailoverHeimdallClient([]Endpoint{
+		{Name: "primary", Client: primary},
+		{Name: "secondary", Client: secondary},
+	}, crossCheckSpans)
+	require.NoError(t, err)
+
+	return client, primary, secondary
+}
+
+func TestNoEndpoints(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFailoverHeimdallClient(nil, false)
+	require.ErrorIs(t, err, ErrNoEndpoints)
+}
+
+func TestFailover(t *testing.T) {
+	t.Parallel()
+
+	client, primary, secondary := newTestClient(t, false)
+
+	// the primary fails once, after which the healthy secondary is preferred
+	primary.EXPECT().FetchCheckpointCount(gomock.Any()).Return(int64(0), errUnavailable).Times(1)
+	secondary.EXPECT().FetchCheckpointCount(gomock.Any()).Return(int64(7), nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		count, err := client.FetchCheckpointCount(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, int64(7), count)
+	}
+
+	health := client.Health()
+	require.Len(t, health, 2)
+
+	require.Equal(t, "primary", health[0].Name)
+	require.False(t, health[0].Healthy())
+	require.Equal(t, uint64(1), health[0].Requests)
+	require.Equal(t, 1.0, health[0].ErrorRate())
+	require.Equal(t, errUnavailable.Error(), health[0].LastError)
+
+	require.Equal(t, "secondary", health[1].Name)
+	require.True(t, health[1].Healthy())
+	require.Equal(t, uint64(2), health[1].Requests)
+	require.Equal(t, 0.0, health[1].ErrorRate())
+}
+
+func TestCancelledContext(t *testing.T) {
+	t.Parallel()
+
+	client, primary, secondary := newTestClient(t, false)
+
+	primary.EXPECT().FetchCheckpointCount(gomock.Any()).Return(int64(0), errUnavailable).AnyTimes()
+	secondary.EXPECT().FetchCheckpointCount(gomock.Any()).Return(int64(0), errUnavailable).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.FetchCheckpointCount(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMilestonesNotSupported(t *testing.T) {
+	t.Parallel()
+
+	client, primary, secondary := newTestClient(t, false)
+
+	// the endpoint not supporting milestones is skipped without being unhealthy
+	primary.EXPECT().FetchMilestoneCount(gomock.Any()).Return(int64(0), milestone.ErrNotSupported).Times(1)
+	secondary.EXPECT().FetchMilestoneCount(gomock.Any()).Return(int64(3), nil).Times(1)
+
+	count, err := client.FetchMilestoneCount(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	health := client.Health()
+	require.True(t, health[0].Healthy())
+	require.Equal(t, uint64(0), health[0].Requests)
+	require.Equal(t, uint64(1), health[1].Requests)
+
+	// without any endpoint supporting milestones, the error is returned at once
+	primary.EXPECT().FetchMilestone(gomock.Any()).Return(nil, milestone.ErrNotSupported).Times(1)
+	secondary.EXPECT().FetchMilestone(gomock.Any()).Return(nil, milestone.ErrNotSupported).Times(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err = client.FetchMilestone(ctx)
+	require.ErrorIs(t, err, milestone.ErrNotSupported)
+
+	for _, h := range client.Health() {
+		require.True(t, h.Healthy())
+	}
+}
+
+func TestCrossCheckSpans(t *testing.T) {
+	t.Parallel()
+
+	client, primary, secondary := newTestClient(t, true)
+
+	heimdallSpan := &span.HeimdallSpan{
+		Span:    span.Span{ID: 1, StartBlock: 256, EndBlock: 6655},
+		ChainID: "15001",
+	}
+
+	primary.EXPECT().Span(gomock.Any(), uint64(1)).Return(heimdallSpan, nil).Times(1)
+	secondary.EXPECT().Span(gomock.Any(), uint64(1)).Return(heimdallSpan, nil).Times(1)
+
+	res, err := client.Span(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, heimdallSpan, res)
+
+	other := *heimdallSpan
+	other.EndBlock = 6400
+
+	primary.EXPECT().Span(gomock.Any(), uint64(1)).Return(heimdallSpan, nil).Times(1)
+	secondary.EXPECT().Span(gomock.Any(), uint64(1)).Return(&other, nil).Times(1)
+
+	_, err = client.Span(context.Background(), 1)
+	require.ErrorIs(t, err, ErrSpanMismatch)
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
 	"github.com/ethereum/go-ethereum/log"
 
 	proto "github.com/maticnetwork/polyproto/heimdall"
@@ -49,3 +50,13 @@ func (h *HeimdallGRPCClient) FetchCheckpoint(ctx context.Context, number int64)
 
 	return checkpoint, nil
 }
+
+// FetchMilestone is not supported, as the heimdall gRPC service doesn't expose milestones yet
+func (h *HeimdallGRPCClient) FetchMilestone(_ context.Context) (*milestone.Milestone, error) {
+	return nil, milestone.ErrNotSupported
+}
+
+// FetchMilestoneCount is not supported, as the heimdall gRPC service doesn't expose milestones yet
+func (h *HeimdallGRPCClient) FetchMilestoneCount(_ context.Context) (int64, error) {
+	return 0, milestone.ErrNotSupported
+}
@@ -0,0 +1,342 @@
+package heimdallreplay
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	// ErrNotRecorded is returned in replay mode for a request missing in the fixture
+	ErrNotRecorded = errors.New("heimdall response not recorded")
+)
+
+const (
+	methodSpan            = "span"
+	methodStateSyncEvents = "state_sync_events"
+	methodCheckpoint      = "checkpoint"
+	methodCheckpointCount = "checkpoint_count"
+	methodMilestone       = "milestone"
+	methodMilestoneCount  = "milestone_count"
+)
+
+// entry is a single line of a fixture file, holding one request along with
+// the response heimdall gave to it.
+type entry struct {
+	Method string          `json:"method"`
+	SpanID uint64          `json:"span_id,omitempty"`
+	FromID uint64          `json:"from_id,omitempty"`
+	To     int64           `json:"to,omitempty"`
+	Number int64           `json:"number,omitempty"`
+	Result json.RawMessage `json:"result"`
+}
+
+type stateSyncKey struct {
+	fromID uint64
+	to     int64
+}
+
+// HeimdallRecordClient wraps another heimdall client and appends every
+// successful response to a JSONL fixture file, which can later be served by a
+// HeimdallReplayClient.
+type HeimdallRecordClient struct {
+	client bor.IHeimdallClient
+
+	lock sync.Mutex
+	file *os.File
+}
+
+// NewHeimdallRecordClient creates a client recording all responses of the given
+// client to the fixture file at path. An existing file is appended to.
+func NewHeimdallRecordClient(client bor.IHeimdallClient, path string) (*HeimdallRecordClient, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Recording Heimdall responses", "file", path)
+
+	return &HeimdallRecordClient{
+		client: client,
+		file:   file,
+	}, nil
+}
+
+func (h *HeimdallRecordClient) record(e entry, result interface{}) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		log.Warn("Failed to encode Heimdall response for recording", "method", e.Method, "err", err)
+		return
+	}
+
+	e.Result = data
+
+	line, err := json.Marshal(e)
+	if err != nil {
+		log.Warn("Failed to encode Heimdall recording", "method", e.Method, "err", err)
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if _, err := h.file.Write(append(line, '\n')); err != nil {
+		log.Warn("Failed to record Heimdall response", "method", e.Method, "err", err)
+	}
+}
+
+func (h *HeimdallRecordClient) StateSyncEvents(ctx context.Context, fromID uint64, to int64) ([]*clerk.EventRecordWithTime, error) {
+	eventRecords, err := h.client.StateSyncEvents(ctx, fromID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	h.record(entry{Method: methodStateSyncEvents, FromID: fromID, To: to}, eventRecords)
+
+	return eventRecords, nil
+}
+
+func (h *HeimdallRecordClient) Span(ctx context.Context, spanID uint64) (*span.HeimdallSpan, error) {
+	heimdallSpan, err := h.client.Span(ctx, spanID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.record(entry{Method: methodSpan, SpanID: spanID}, heimdallSpan)
+
+	return heimdallSpan, nil
+}
+
+func (h *HeimdallRecordClient) FetchCheckpoint(ctx context.Context, number int64) (*checkpoint.Checkpoint, error) {
+	cp, err := h.client.FetchCheckpoint(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	h.record(entry{Method: methodCheckpoint, Number: number}, cp)
+
+	return cp, nil
+}
+
+func (h *HeimdallRecordClient) FetchCheckpointCount(ctx context.Context) (int64, error) {
+	count, err := h.client.FetchCheckpointCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	h.record(entry{Method: methodCheckpointCount}, count)
+
+	return count, nil
+}
+
+func (h *HeimdallRecordClient) FetchMilestone(ctx context.Context) (*milestone.Milestone, error) {
+	m, err := h.client.FetchMilestone(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	h.record(entry{Method: methodMilestone}, m)
+
+	return m, nil
+}
+
+func (h *HeimdallRecordClient) FetchMilestoneCount(ctx context.Context) (int64, error) {
+	count, err := h.client.FetchMilestoneCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	h.record(entry{Method: methodMilestoneCount}, count)
+
+	return count, nil
+}
+
+// Unwrap returns the underlying heimdall client.
+func (h *HeimdallRecordClient) Unwrap() bor.IHeimdallClient {
+	return h.client
+}
+
+// Close closes the underlying heimdall client and the fixture file.
+func (h *HeimdallRecordClient) Close() {
+	h.client.Close()
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if err := h.file.Close(); err != nil {
+		log.Warn("Failed to close Heimdall recording", "err", err)
+	}
+}
+
+// HeimdallReplayClient serves heimdall responses from a fixture file, without
+// any network access. Requests must match a recorded one exactly, which is the
+// case when replaying the same chain, as all bor queries derive from it.
+type HeimdallReplayClient struct {
+	spans           map[uint64]*span.HeimdallSpan
+	stateSyncEvents map[stateSyncKey][]*clerk.EventRecordWithTime
+	checkpoints     map[int64]*checkpoint.Checkpoint
+	checkpointCount *int64
+	milestone       *milestone.Milestone
+	milestoneCount  *int64
+}
+
+// NewHeimdallReplayClient loads the fixture file at path. If a request was
+// recorded multiple times, the latest response wins.
+func NewHeimdallReplayClient(path string) (*HeimdallReplayClient, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	h := &HeimdallReplayClient{
+		spans:           make(map[uint64]*span.HeimdallSpan),
+		stateSyncEvents: make(map[stateSyncKey][]*clerk.EventRecordWithTime),
+		checkpoints:     make(map[int64]*checkpoint.Checkpoint),
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		if err := h.load(scanner.Bytes()); err != nil {
+			return nil, fmt.Errorf("invalid heimdall fixture %s, line %d: %w", path, line, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	log.Info("Replaying Heimdall responses", "file", path, "spans", len(h.spans), "state-sync queries", len(h.stateSyncEvents), "checkpoints", len(h.checkpoints))
+
+	return h, nil
+}
+
+func (h *HeimdallReplayClient) load(line []byte) error {
+	var e entry
+	if err := json.Unmarshal(line, &e); err != nil {
+		return err
+	}
+
+	switch e.Method {
+	case methodSpan:
+		heimdallSpan := new(span.HeimdallSpan)
+		if err := json.Unmarshal(e.Result, heimdallSpan); err != nil {
+			return err
+		}
+
+		h.spans[e.SpanID] = heimdallSpan
+	case methodStateSyncEvents:
+		var eventRecords []*clerk.EventRecordWithTime
+		if err := json.Unmarshal(e.Result, &eventRecords); err != nil {
+			return err
+		}
+
+		h.stateSyncEvents[stateSyncKey{e.FromID, e.To}] = eventRecords
+	case methodCheckpoint:
+		cp := new(checkpoint.Checkpoint)
+		if err := json.Unmarshal(e.Result, cp); err != nil {
+			return err
+		}
+
+		h.checkpoints[e.Number] = cp
+	case methodCheckpointCount:
+		count := new(int64)
+		if err := json.Unmarshal(e.Result, count); err != nil {
+			return err
+		}
+
+		h.checkpointCount = count
+	case methodMilestone:
+		m := new(milestone.Milestone)
+		if err := json.Unmarshal(e.Result, m); err != nil {
+			return err
+		}
+
+		h.milestone = m
+	case methodMilestoneCount:
+		count := new(int64)
+		if err := json.Unmarshal(e.Result, count); err != nil {
+			return err
+		}
+
+		h.milestoneCount = count
+	default:
+		return fmt.Errorf("unknown method %q", e.Method)
+	}
+
+	return nil
+}
+
+func (h *HeimdallReplayClient) StateSyncEvents(_ context.Context, fromID uint64, to int64) ([]*clerk.EventRecordWithTime, error) {
+	eventRecords, ok := h.stateSyncEvents[stateSyncKey{fromID, to}]
+	if !ok {
+		return nil, fmt.Errorf("%w: state sync events from id %d to time %d", ErrNotRecorded, fromID, to)
+	}
+
+	return eventRecords, nil
+}
+
+func (h *HeimdallReplayClient) Span(_ context.Context, spanID uint64) (*span.HeimdallSpan, error) {
+	heimdallSpan, ok := h.spans[spanID]
+	if !ok {
+		return nil, fmt.Errorf("%w: span %d", ErrNotRecorded, spanID)
+	}
+
+	return heimdallSpan, nil
+}
+
+func (h *HeimdallReplayClient) FetchCheckpoint(_ context.Context, number int64) (*checkpoint.Checkpoint, error) {
+	cp, ok := h.checkpoints[number]
+	if !ok {
+		return nil, fmt.Errorf("%w: checkpoint %d", ErrNotRecorded, number)
+	}
+
+	return cp, nil
+}
+
+func (h *HeimdallReplayClient) FetchCheckpointCount(_ context.Context) (int64, error) {
+	if h.checkpointCount == nil {
+		return 0, fmt.Errorf("%w: checkpoint count", ErrNotRecorded)
+	}
+
+	return *h.checkpointCount, nil
+}
+
+// FetchMilestone returns the latest recorded milestone. Milestones are fetched
+// periodically rather than derived from the chain, so replaying can't be exact.
+func (h *HeimdallReplayClient) FetchMilestone(_ context.Context) (*milestone.Milestone, error) {
+	if h.milestone == nil {
+		return nil, fmt.Errorf("%w: milestone", ErrNotRecorded)
+	}
+
+	return h.milestone, nil
+}
+
+func (h *HeimdallReplayClient) FetchMilestoneCount(_ context.Context) (int64, error) {
+	if h.milestoneCount == nil {
+		return 0, fmt.Errorf("%w: milestone count", ErrNotRecorded)
+	}
+
+	return *h.milestoneCount, nil
+}
+
+func (h *HeimdallReplayClient) Close() {
+	// Nothing to close as of now
+}
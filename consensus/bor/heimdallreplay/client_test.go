@@ -0,0 +1,118 @@
+package heimdallreplay
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/tests/bor/mocks"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	path := filepath.Join(t.TempDir(), "heimdall.jsonl")
+
+	validator := valset.Validator{
+		ID:          1,
+		Address:     common.HexToAddress("0x1"),
+		VotingPower: 100,
+	}
+
+	heimdallSpan := &span.HeimdallSpan{
+		Span: span.Span{ID: 1, StartBlock: 256, EndBlock: 6655},
+		ValidatorSet: valset.ValidatorSet{
+			Validators: []*valset.Validator{&validator},
+			Proposer:   &validator,
+		},
+		SelectedProducers: []valset.Validator{validator},
+		ChainID:           "15001",
+	}
+
+	eventRecords := []*clerk.EventRecordWithTime{
+		{
+			EventRecord: clerk.EventRecord{
+				ID:       1,
+				Contract: common.HexToAddress("0x2"),
+				Data:     []byte{0x1, 0x2},
+				TxHash:   common.HexToHash("0x3"),
+				ChainID:  "15001",
+			},
+			Time: time.Unix(1_000_000, 0).UTC(),
+		},
+	}
+
+	cp := &checkpoint.Checkpoint{
+		Proposer:   common.HexToAddress("0x1"),
+		StartBlock: big.NewInt(0),
+		EndBlock:   big.NewInt(255),
+		RootHash:   common.HexToHash("0x4"),
+		BorChainID: "15001",
+		Timestamp:  1_000_000,
+	}
+
+	heimdall := mocks.NewMockIHeimdallClient(ctrl)
+	heimdall.EXPECT().Span(gomock.Any(), uint64(1)).Return(heimdallSpan, nil)
+	heimdall.EXPECT().StateSyncEvents(gomock.Any(), uint64(1), int64(1_000_010)).Return(eventRecords, nil)
+	heimdall.EXPECT().FetchCheckpoint(gomock.Any(), int64(1)).Return(cp, nil)
+	heimdall.EXPECT().FetchCheckpointCount(gomock.Any()).Return(int64(1), nil)
+	heimdall.EXPECT().Close()
+
+	recorder, err := NewHeimdallRecordClient(heimdall, path)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = recorder.Span(ctx, 1)
+	require.NoError(t, err)
+	_, err = recorder.StateSyncEvents(ctx, 1, 1_000_010)
+	require.NoError(t, err)
+	_, err = recorder.FetchCheckpoint(ctx, 1)
+	require.NoError(t, err)
+	_, err = recorder.FetchCheckpointCount(ctx)
+	require.NoError(t, err)
+
+	recorder.Close()
+
+	replay, err := NewHeimdallReplayClient(path)
+	require.NoError(t, err)
+
+	resSpan, err := replay.Span(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, heimdallSpan, resSpan)
+
+	resEvents, err := replay.StateSyncEvents(ctx, 1, 1_000_010)
+	require.NoError(t, err)
+	require.Equal(t, eventRecords, resEvents)
+
+	resCheckpoint, err := replay.FetchCheckpoint(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, cp, resCheckpoint)
+
+	count, err := replay.FetchCheckpointCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// anything not recorded must fail rather than silently return nothing
+	_, err = replay.Span(ctx, 2)
+	require.ErrorIs(t, err, ErrNotRecorded)
+
+	_, err = replay.StateSyncEvents(ctx, 2, 1_000_010)
+	require.ErrorIs(t, err, ErrNotRecorded)
+
+	_, err = replay.FetchCheckpoint(ctx, -1)
+	require.ErrorIs(t, err, ErrNotRecorded)
+}
@@ -0,0 +1,544 @@
+package heimdallsim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	// ErrNoValidators is returned if the simulator is configured without validators
+	ErrNoValidators = errors.New("no validators configured")
+
+	// ErrNoChain is returned when checkpoints are requested without a chain to build them from
+	ErrNoChain = errors.New("no chain to build checkpoints from")
+
+	// ErrCheckpointNotFound is returned for a checkpoint the chain hasn't reached yet
+	ErrCheckpointNotFound = errors.New("checkpoint not found")
+
+	// ErrMilestoneNotFound is returned when the chain hasn't reached the first milestone yet
+	ErrMilestoneNotFound = errors.New("milestone not found")
+)
+
+const (
+	// defaultZeroSpanEnd is the last block of the genesis span, as in the bor genesis contracts
+	defaultZeroSpanEnd = 255
+
+	defaultSpanLength       = 6400
+	defaultCheckpointLength = 256
+	defaultMilestoneLength  = 16
+	defaultStateFetchLimit  = 50
+)
+
+// ChainReader gives the simulator access to the local chain, for building
+// checkpoints. It is satisfied by ethclient.Client.
+type ChainReader interface {
+	// HeaderByNumber returns the header with the given number, or the latest one if number is nil
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Config is the configuration of a heimdall simulator.
+type Config struct {
+	// ChainID is the bor chain id reported in spans, events and checkpoints
+	ChainID string
+
+	// Validators is the validator set spans are rotated from
+	Validators []*valset.Validator
+
+	// ZeroSpanEnd is the last block of the genesis span set in the genesis
+	// contracts, 255 if zero
+	ZeroSpanEnd uint64
+
+	// SpanLength is the number of blocks per span, after the genesis span
+	SpanLength uint64
+
+	// ProducerCount is the number of selected producers per span, all validators if zero
+	ProducerCount int
+
+	// CheckpointLength is the number of blocks per checkpoint
+	CheckpointLength uint64
+
+	// MilestoneLength is the number of blocks per milestone
+	MilestoneLength uint64
+}
+
+// Simulator is a fake heimdall serving the REST endpoints used by the heimdall
+// client. Spans rotate deterministically through the configured validator set,
+// state-sync events are injected by the caller and checkpoints are built from
+// the local chain.
+type Simulator struct {
+	config Config
+	chain  ChainReader
+
+	lock        sync.RWMutex
+	events      []*clerk.EventRecordWithTime
+	checkpoints map[uint64]*checkpoint.Checkpoint
+}
+
+// New creates a heimdall simulator. The chain may be nil, in which case no
+// checkpoints are served.
+func New(config Config, chain ChainReader) (*Simulator, error) {
+	if len(config.Validators) == 0 {
+		return nil, ErrNoValidators
+	}
+
+	seen := make(map[common.Address]bool, len(config.Validators))
+
+	for _, v := range config.Validators {
+		if v.VotingPower <= 0 {
+			return nil, fmt.Errorf("validator %s has no voting power", v.Address)
+		}
+
+		if seen[v.Address] {
+			return nil, fmt.Errorf("duplicate validator %s", v.Address)
+		}
+
+		seen[v.Address] = true
+	}
+
+	if config.ZeroSpanEnd == 0 {
+		config.ZeroSpanEnd = defaultZeroSpanEnd
+	}
+
+	if config.SpanLength == 0 {
+		config.SpanLength = defaultSpanLength
+	}
+
+	if config.CheckpointLength == 0 {
+		config.CheckpointLength = defaultCheckpointLength
+	}
+
+	if config.MilestoneLength == 0 {
+		config.MilestoneLength = defaultMilestoneLength
+	}
+
+	if config.ProducerCount <= 0 || config.ProducerCount > len(config.Validators) {
+		config.ProducerCount = len(config.Validators)
+	}
+
+	return &Simulator{
+		config:      config,
+		chain:       chain,
+		checkpoints: make(map[uint64]*checkpoint.Checkpoint),
+	}, nil
+}
+
+// SetChain sets the chain checkpoints are built from.
+func (s *Simulator) SetChain(chain ChainReader) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.chain = chain
+}
+
+// Span returns the span with the given id. The validator set is rotated by the
+// span id, and the producers are a window over the validators moving by one
+// validator per span.
+func (s *Simulator) Span(spanID uint64) *span.HeimdallSpan {
+	startBlock, endBlock := uint64(0), s.config.ZeroSpanEnd
+	if spanID > 0 {
+		startBlock = s.config.ZeroSpanEnd + 1 + (spanID-1)*s.config.SpanLength
+		endBlock = startBlock + s.config.SpanLength - 1
+	}
+
+	validatorSet := s.validatorSet()
+	validatorSet.IncrementProposerPriority(int(spanID%uint64(len(s.config.Validators))) + 1)
+
+	producers := make([]valset.Validator, 0, s.config.ProducerCount)
+	for i := 0; i < s.config.ProducerCount; i++ {
+		producer := s.config.Validators[(int(spanID)+i)%len(s.config.Validators)]
+		producers = append(producers, *producer.Copy())
+	}
+
+	return &span.HeimdallSpan{
+		Span: span.Span{
+			ID:         spanID,
+			StartBlock: startBlock,
+			EndBlock:   endBlock,
+		},
+		ValidatorSet:      *validatorSet,
+		SelectedProducers: producers,
+		ChainID:           s.config.ChainID,
+	}
+}
+
+// validatorSet returns a fresh validator set of the configured validators
+func (s *Simulator) validatorSet() *valset.ValidatorSet {
+	validators := make([]*valset.Validator, 0, len(s.config.Validators))
+	for _, v := range s.config.Validators {
+		validators = append(validators, v.Copy())
+	}
+
+	return valset.NewValidatorSet(validators)
+}
+
+// AddStateSyncEvent injects a state-sync event with the next id, recorded now.
+func (s *Simulator) AddStateSyncEvent(contract common.Address, data []byte) *clerk.EventRecordWithTime {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := uint64(len(s.events)) + 1
+
+	event := &clerk.EventRecordWithTime{
+		EventRecord: clerk.EventRecord{
+			ID:       id,
+			Contract: contract,
+			Data:     common.CopyBytes(data),
+			TxHash:   crypto.Keccak256Hash(new(big.Int).SetUint64(id).Bytes()),
+			LogIndex: 0,
+			ChainID:  s.config.ChainID,
+		},
+		Time: time.Now().UTC().Truncate(time.Second),
+	}
+
+	s.events = append(s.events, event)
+
+	log.Info("Injected state-sync event", "id", id, "contract", contract)
+
+	return event
+}
+
+// StateSyncEvents returns at most limit events with an id of at least fromID,
+// recorded before the given time.
+func (s *Simulator) StateSyncEvents(fromID uint64, to int64, limit int) []*clerk.EventRecordWithTime {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	events := make([]*clerk.EventRecordWithTime, 0)
+
+	if fromID == 0 {
+		fromID = 1
+	}
+
+	for i := fromID - 1; i < uint64(len(s.events)) && len(events) < limit; i++ {
+		if s.events[i].Time.Unix() >= to {
+			break
+		}
+
+		events = append(events, s.events[i])
+	}
+
+	return events
+}
+
+// CheckpointCount returns the number of checkpoints the local chain has reached.
+func (s *Simulator) CheckpointCount(ctx context.Context) (int64, error) {
+	s.lock.RLock()
+	chain := s.chain
+	s.lock.RUnlock()
+
+	if chain == nil {
+		return 0, ErrNoChain
+	}
+
+	head, err := chain.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64((head.Number.Uint64() + 1) / s.config.CheckpointLength), nil
+}
+
+// Checkpoint returns the checkpoint with the given number, counting from one.
+// Checkpoint n covers the blocks [(n-1)*length, n*length-1] of the local chain.
+func (s *Simulator) Checkpoint(ctx context.Context, number uint64) (*checkpoint.Checkpoint, error) {
+	s.lock.RLock()
+	cp, ok := s.checkpoints[number]
+	chain := s.chain
+	s.lock.RUnlock()
+
+	if ok {
+		return cp, nil
+	}
+
+	if chain == nil {
+		return nil, ErrNoChain
+	}
+
+	count, err := s.CheckpointCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if number == 0 || number > uint64(count) {
+		return nil, fmt.Errorf("%w: %d", ErrCheckpointNotFound, number)
+	}
+
+	start := (number - 1) * s.config.CheckpointLength
+	end := number*s.config.CheckpointLength - 1
+
+	headers := make([]*types.Header, 0, s.config.CheckpointLength)
+
+	for i := start; i <= end; i++ {
+		header, err := chain.HeaderByNumber(ctx, new(big.Int).SetUint64(i))
+		if err != nil {
+			return nil, err
+		}
+
+		headers = append(headers, header)
+	}
+
+	rootHash, err := bor.ComputeRootHash(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSet := s.validatorSet()
+	validatorSet.IncrementProposerPriority(int(number))
+
+	cp = &checkpoint.Checkpoint{
+		Proposer:   validatorSet.GetProposer().Address,
+		StartBlock: new(big.Int).SetUint64(start),
+		EndBlock:   new(big.Int).SetUint64(end),
+		RootHash:   common.BytesToHash(rootHash),
+		BorChainID: s.config.ChainID,
+		Timestamp:  headers[len(headers)-1].Time,
+	}
+
+	s.lock.Lock()
+	s.checkpoints[number] = cp
+	s.lock.Unlock()
+
+	return cp, nil
+}
+
+// MilestoneCount returns the number of milestones the local chain has reached.
+func (s *Simulator) MilestoneCount(ctx context.Context) (int64, error) {
+	s.lock.RLock()
+	chain := s.chain
+	s.lock.RUnlock()
+
+	if chain == nil {
+		return 0, ErrNoChain
+	}
+
+	head, err := chain.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64((head.Number.Uint64() + 1) / s.config.MilestoneLength), nil
+}
+
+// LatestMilestone returns the latest milestone the local chain has reached.
+// Milestone n covers the blocks [(n-1)*length, n*length-1] of the local chain.
+func (s *Simulator) LatestMilestone(ctx context.Context) (*milestone.Milestone, error) {
+	s.lock.RLock()
+	chain := s.chain
+	s.lock.RUnlock()
+
+	if chain == nil {
+		return nil, ErrNoChain
+	}
+
+	count, err := s.MilestoneCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		return nil, ErrMilestoneNotFound
+	}
+
+	number := uint64(count)
+	start := (number - 1) * s.config.MilestoneLength
+	end := number*s.config.MilestoneLength - 1
+
+	header, err := chain.HeaderByNumber(ctx, new(big.Int).SetUint64(end))
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSet := s.validatorSet()
+	validatorSet.IncrementProposerPriority(int(number))
+
+	return &milestone.Milestone{
+		Proposer:   validatorSet.GetProposer().Address,
+		StartBlock: new(big.Int).SetUint64(start),
+		EndBlock:   new(big.Int).SetUint64(end),
+		Hash:       header.Hash(),
+		BorChainID: s.config.ChainID,
+		Timestamp:  header.Time,
+	}, nil
+}
+
+// Handler returns the HTTP handler serving the heimdall REST endpoints, along
+// with an endpoint to inject state-sync events.
+func (s *Simulator) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/bor/span/", s.handleSpan)
+	mux.HandleFunc("/clerk/event-record/list", s.handleStateSyncEvents)
+	mux.HandleFunc("/clerk/event-record", s.handleAddStateSyncEvent)
+	mux.HandleFunc("/checkpoints/", s.handleCheckpoint)
+	mux.HandleFunc("/milestone/latest", s.handleLatestMilestone)
+	mux.HandleFunc("/milestone/count", s.handleMilestoneCount)
+
+	return mux
+}
+
+func (s *Simulator) handleSpan(w http.ResponseWriter, r *http.Request) {
+	spanID, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/bor/span/"), 10, 64)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeResult(w, s.Span(spanID))
+}
+
+func (s *Simulator) handleStateSyncEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	fromID, err := strconv.ParseUint(query.Get("from-id"), 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid from-id: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	to, err := strconv.ParseInt(query.Get("to-time"), 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid to-time: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	limit := defaultStateFetchLimit
+	if raw := query.Get("limit"); raw != "" {
+		if limit, err = strconv.Atoi(raw); err != nil {
+			http.Error(w, fmt.Sprintf("invalid limit: %v", err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	writeResult(w, s.StateSyncEvents(fromID, to, limit))
+}
+
+// addStateSyncEventRequest is the body of a request injecting a state-sync event
+type addStateSyncEventRequest struct {
+	Contract common.Address `json:"contract"`
+	Data     hexutil.Bytes  `json:"data"`
+}
+
+func (s *Simulator) handleAddStateSyncEvent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req addStateSyncEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeResult(w, s.AddStateSyncEvent(req.Contract, req.Data))
+}
+
+func (s *Simulator) handleCheckpoint(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	arg := strings.TrimPrefix(r.URL.Path, "/checkpoints/")
+
+	if arg == "count" {
+		count, err := s.CheckpointCount(ctx)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeResult(w, checkpoint.CheckpointCount{Result: count})
+
+		return
+	}
+
+	var number uint64
+
+	if arg == "latest" {
+		count, err := s.CheckpointCount(ctx)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		number = uint64(count)
+	} else {
+		var err error
+		if number, err = strconv.ParseUint(arg, 10, 64); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	cp, err := s.Checkpoint(ctx, number)
+	if errors.Is(err, ErrCheckpointNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeResult(w, cp)
+}
+
+func (s *Simulator) handleLatestMilestone(w http.ResponseWriter, r *http.Request) {
+	m, err := s.LatestMilestone(r.Context())
+	if errors.Is(err, ErrMilestoneNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeResult(w, m)
+}
+
+func (s *Simulator) handleMilestoneCount(w http.ResponseWriter, r *http.Request) {
+	count, err := s.MilestoneCount(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeResult(w, milestone.MilestoneCount{Count: count})
+}
+
+// writeResult writes the value wrapped like a heimdall REST response
+func writeResult(w http.ResponseWriter, result interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	response := struct {
+		Height string      `json:"height"`
+		Result interface{} `json:"result"`
+	}{
+		Height: "0",
+		Result: result,
+	}
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Warn("Failed to write heimdall simulator response", "err", err)
+	}
+}
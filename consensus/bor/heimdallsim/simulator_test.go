@@ -0,0 +1,169 @@
+package heimdallsim
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// fakeChain is a chain of empty headers up to head
+type fakeChain struct {
+	head uint64
+}
+
+func (c *fakeChain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		number = new(big.Int).SetUint64(c.head)
+	}
+
+	return &types.Header{Number: new(big.Int).Set(number), Time: 1_000_000 + 2*number.Uint64()}, nil
+}
+
+func newTestSimulator(t *testing.T, chain ChainReader) (*Simulator, *heimdall.HeimdallClient) {
+	t.Helper()
+
+	sim, err := New(Config{
+		ChainID: "15001",
+		Validators: []*valset.Validator{
+			valset.NewValidator(common.HexToAddress("0x1"), 10),
+			valset.NewValidator(common.HexToAddress("0x2"), 20),
+			valset.NewValidator(common.HexToAddress("0x3"), 30),
+		},
+		SpanLength:       64,
+		ProducerCount:    2,
+		CheckpointLength: 16,
+		MilestoneLength:  4,
+	}, chain)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(sim.Handler())
+	t.Cleanup(srv.Close)
+
+	client := heimdall.NewHeimdallClient(srv.URL)
+	t.Cleanup(client.Close)
+
+	return sim, client
+}
+
+func TestInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(Config{}, nil)
+	require.ErrorIs(t, err, ErrNoValidators)
+
+	_, err = New(Config{Validators: []*valset.Validator{valset.NewValidator(common.HexToAddress("0x1"), 0)}}, nil)
+	require.Error(t, err)
+}
+
+func TestSpanRotation(t *testing.T) {
+	t.Parallel()
+
+	_, client := newTestSimulator(t, nil)
+
+	span1, err := client.Span(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(256), span1.StartBlock)
+	require.Equal(t, uint64(319), span1.EndBlock)
+	require.Equal(t, "15001", span1.ChainID)
+	require.Len(t, span1.ValidatorSet.Validators, 3)
+	require.Len(t, span1.SelectedProducers, 2)
+
+	span2, err := client.Span(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, span1.EndBlock+1, span2.StartBlock)
+
+	// the producer window moves by one validator per span
+	require.Equal(t, span1.SelectedProducers[1].Address, span2.SelectedProducers[0].Address)
+}
+
+func TestStateSyncEvents(t *testing.T) {
+	t.Parallel()
+
+	sim, client := newTestSimulator(t, nil)
+
+	for i := 0; i < 60; i++ {
+		sim.AddStateSyncEvent(common.HexToAddress("0x1001"), []byte{byte(i)})
+	}
+
+	to := time.Now().Add(time.Second).Unix()
+
+	// more events than the client fetches per page
+	events, err := client.StateSyncEvents(context.Background(), 1, to)
+	require.NoError(t, err)
+	require.Len(t, events, 60)
+
+	for i, event := range events {
+		require.Equal(t, uint64(i+1), event.ID)
+		require.Equal(t, "15001", event.ChainID)
+	}
+
+	events, err = client.StateSyncEvents(context.Background(), 55, to)
+	require.NoError(t, err)
+	require.Len(t, events, 6)
+
+	// nothing is recorded before the events were injected
+	events, err = client.StateSyncEvents(context.Background(), 1, events[0].Time.Add(-time.Minute).Unix())
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	chain := &fakeChain{head: 40}
+	_, client := newTestSimulator(t, chain)
+
+	count, err := client.FetchCheckpointCount(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	cp, err := client.FetchCheckpoint(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), cp.StartBlock.Uint64())
+	require.Equal(t, uint64(31), cp.EndBlock.Uint64())
+	require.Equal(t, "15001", cp.BorChainID)
+
+	headers := make([]*types.Header, 0, 16)
+	for i := int64(16); i <= 31; i++ {
+		header, _ := chain.HeaderByNumber(context.Background(), big.NewInt(i))
+		headers = append(headers, header)
+	}
+
+	rootHash, err := bor.ComputeRootHash(headers)
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToHash(rootHash), cp.RootHash)
+
+	latest, err := client.FetchCheckpoint(context.Background(), -1)
+	require.NoError(t, err)
+	require.Equal(t, cp, latest)
+}
+
+func TestMilestones(t *testing.T) {
+	t.Parallel()
+
+	chain := &fakeChain{head: 42}
+	_, client := newTestSimulator(t, chain)
+
+	count, err := client.FetchMilestoneCount(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(10), count)
+
+	m, err := client.FetchMilestone(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(36), m.StartBlock.Uint64())
+	require.Equal(t, uint64(39), m.EndBlock.Uint64())
+	require.Equal(t, "15001", m.BorChainID)
+
+	header, _ := chain.HeaderByNumber(context.Background(), big.NewInt(39))
+	require.Equal(t, header.Hash(), m.Hash)
+}
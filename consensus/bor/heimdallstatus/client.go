@@ -0,0 +1,189 @@
+package heimdallstatus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallfailover"
+)
+
+// Status is the outcome of the recent requests to heimdall.
+type Status struct {
+	Requests     uint64
+	Errors       uint64
+	LastSuccess  time.Time
+	LastFailure  time.Time
+	LastError    string
+	PendingSince time.Time // start of the oldest request still waiting for heimdall, zero if none
+}
+
+// Reachable reports whether heimdall answered the last request, and no request
+// has been waiting for it for longer than the timeout. Heimdall is assumed to be
+// reachable until the first request.
+func (s Status) Reachable(timeout time.Duration, now time.Time) bool {
+	if !s.PendingSince.IsZero() && now.Sub(s.PendingSince) > timeout {
+		return false
+	}
+
+	return !s.LastFailure.After(s.LastSuccess)
+}
+
+// HeimdallStatusClient wraps another heimdall client and keeps track of the
+// outcome of the requests made through it, to report the reachability of
+// heimdall. The requests retried by the wrapped client until heimdall answers
+// are reported as pending meanwhile.
+type HeimdallStatusClient struct {
+	client bor.IHeimdallClient
+
+	lock    sync.Mutex
+	status  Status
+	pending map[uint64]time.Time // start of the requests in flight, by request number
+	nextID  uint64
+}
+
+// NewHeimdallStatusClient creates a heimdall client tracking the status of the
+// requests to the given one.
+func NewHeimdallStatusClient(client bor.IHeimdallClient) *HeimdallStatusClient {
+	return &HeimdallStatusClient{
+		client:  client,
+		pending: make(map[uint64]time.Time),
+	}
+}
+
+// Status returns the outcome of the recent requests to heimdall.
+func (h *HeimdallStatusClient) Status() Status {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	status := h.status
+	for _, start := range h.pending {
+		if status.PendingSince.IsZero() || start.Before(status.PendingSince) {
+			status.PendingSince = start
+		}
+	}
+
+	return status
+}
+
+// start registers a request in flight, returning the function recording its
+// outcome. The given errors aren't recorded, as they say nothing about
+// heimdall.
+func (h *HeimdallStatusClient) start(ignored ...error) func(error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	id := h.nextID
+	h.nextID++
+	h.pending[id] = time.Now()
+
+	return func(err error) {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+
+		delete(h.pending, id)
+
+		// Requests cancelled by the node say nothing about heimdall
+		if errors.Is(err, context.Canceled) || errors.Is(err, heimdall.ErrShutdownDetected) || errors.Is(err, heimdallfailover.ErrShutdownDetected) {
+			return
+		}
+
+		for _, target := range ignored {
+			if errors.Is(err, target) {
+				return
+			}
+		}
+
+		h.status.Requests++
+
+		if err != nil {
+			h.status.Errors++
+			h.status.LastFailure = time.Now()
+			h.status.LastError = err.Error()
+
+			return
+		}
+
+		h.status.LastSuccess = time.Now()
+	}
+}
+
+func (h *HeimdallStatusClient) StateSyncEvents(ctx context.Context, fromID uint64, to int64) ([]*clerk.EventRecordWithTime, error) {
+	done := h.start()
+
+	eventRecords, err := h.client.StateSyncEvents(ctx, fromID, to)
+	done(err)
+
+	return eventRecords, err
+}
+
+func (h *HeimdallStatusClient) Span(ctx context.Context, spanID uint64) (*span.HeimdallSpan, error) {
+	done := h.start()
+
+	heimdallSpan, err := h.client.Span(ctx, spanID)
+	done(err)
+
+	return heimdallSpan, err
+}
+
+// FetchCheckpoint fetches the checkpoint from heimdall
+func (h *HeimdallStatusClient) FetchCheckpoint(ctx context.Context, number int64) (*checkpoint.Checkpoint, error) {
+	done := h.start()
+
+	cp, err := h.client.FetchCheckpoint(ctx, number)
+	done(err)
+
+	return cp, err
+}
+
+// FetchCheckpointCount fetches the checkpoint count from heimdall
+func (h *HeimdallStatusClient) FetchCheckpointCount(ctx context.Context) (int64, error) {
+	done := h.start()
+
+	count, err := h.client.FetchCheckpointCount(ctx)
+	done(err)
+
+	return count, err
+}
+
+// errMilestoneIgnored are the errors of the milestone requests which aren't
+// failures: heimdall not supporting milestones, and the deadline of the caller
+// reached while the request is retried against a heimdall without milestones.
+var errMilestoneIgnored = []error{milestone.ErrNotSupported, context.DeadlineExceeded}
+
+// FetchMilestone fetches the latest milestone from heimdall
+func (h *HeimdallStatusClient) FetchMilestone(ctx context.Context) (*milestone.Milestone, error) {
+	done := h.start(errMilestoneIgnored...)
+
+	m, err := h.client.FetchMilestone(ctx)
+	done(err)
+
+	return m, err
+}
+
+// FetchMilestoneCount fetches the milestone count from heimdall
+func (h *HeimdallStatusClient) FetchMilestoneCount(ctx context.Context) (int64, error) {
+	done := h.start(errMilestoneIgnored...)
+
+	count, err := h.client.FetchMilestoneCount(ctx)
+	done(err)
+
+	return count, err
+}
+
+// Unwrap returns the underlying heimdall client.
+func (h *HeimdallStatusClient) Unwrap() bor.IHeimdallClient {
+	return h.client
+}
+
+// Close closes the underlying heimdall client.
+func (h *HeimdallStatusClient) Close() {
+	h.client.Close()
+}
@@ -0,0 +1,130 @@
+package heimdallstatus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/tests/bor/mocks"
+)
+
+func TestStatusRecordsRequests(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockIHeimdallClient(ctrl)
+	h := NewHeimdallStatusClient(client)
+
+	// Heimdall is assumed reachable until the first request
+	require.True(t, h.Status().Reachable(time.Minute, time.Now()))
+
+	client.EXPECT().Span(gomock.Any(), uint64(1)).Return(&span.HeimdallSpan{}, nil)
+	_, err := h.Span(context.Background(), 1)
+	require.NoError(t, err)
+	require.True(t, h.Status().Reachable(time.Minute, time.Now()))
+
+	client.EXPECT().FetchMilestoneCount(gomock.Any()).Return(int64(0), errors.New("connection refused"))
+	_, err = h.FetchMilestoneCount(context.Background())
+	require.Error(t, err)
+
+	status := h.Status()
+	require.False(t, status.Reachable(time.Minute, time.Now()))
+	require.Equal(t, uint64(2), status.Requests)
+	require.Equal(t, uint64(1), status.Errors)
+	require.Equal(t, "connection refused", status.LastError)
+
+	// Requests cancelled by the node don't change the reachability
+	client.EXPECT().FetchMilestoneCount(gomock.Any()).Return(int64(1), nil)
+	_, err = h.FetchMilestoneCount(context.Background())
+	require.NoError(t, err)
+
+	client.EXPECT().FetchCheckpointCount(gomock.Any()).Return(int64(0), heimdall.ErrShutdownDetected)
+	_, err = h.FetchCheckpointCount(context.Background())
+	require.Error(t, err)
+
+	status = h.Status()
+	require.True(t, status.Reachable(time.Minute, time.Now()))
+	require.Equal(t, uint64(3), status.Requests)
+}
+
+func TestStatusMilestonesNotSupported(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockIHeimdallClient(ctrl)
+	h := NewHeimdallStatusClient(client)
+
+	client.EXPECT().Span(gomock.Any(), uint64(1)).Return(&span.HeimdallSpan{}, nil)
+	_, err := h.Span(context.Background(), 1)
+	require.NoError(t, err)
+
+	// Heimdall not supporting milestones, or a milestone request retried until
+	// the deadline of the caller, don't change the reachability
+	client.EXPECT().FetchMilestone(gomock.Any()).Return(nil, milestone.ErrNotSupported)
+	_, err = h.FetchMilestone(context.Background())
+	require.ErrorIs(t, err, milestone.ErrNotSupported)
+
+	client.EXPECT().FetchMilestoneCount(gomock.Any()).Return(int64(0), context.DeadlineExceeded)
+	_, err = h.FetchMilestoneCount(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	status := h.Status()
+	require.True(t, status.Reachable(time.Minute, time.Now()))
+	require.Equal(t, uint64(1), status.Requests)
+	require.Zero(t, status.Errors)
+
+	// Other requests reaching their deadline are still failures
+	client.EXPECT().FetchCheckpointCount(gomock.Any()).Return(int64(0), context.DeadlineExceeded)
+	_, err = h.FetchCheckpointCount(context.Background())
+	require.Error(t, err)
+
+	require.False(t, h.Status().Reachable(time.Minute, time.Now()))
+}
+
+func TestStatusPendingRequests(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mocks.NewMockIHeimdallClient(ctrl)
+	h := NewHeimdallStatusClient(client)
+
+	// A request retried by the wrapped client until heimdall answers
+	release := make(chan struct{})
+	client.EXPECT().Span(gomock.Any(), uint64(1)).DoAndReturn(func(ctx context.Context, spanID uint64) (*span.HeimdallSpan, error) {
+		<-release
+		return &span.HeimdallSpan{}, nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		_, _ = h.Span(context.Background(), 1)
+	}()
+
+	require.Eventually(t, func() bool { return !h.Status().PendingSince.IsZero() }, time.Second, time.Millisecond)
+
+	status := h.Status()
+	require.True(t, status.Reachable(time.Minute, time.Now()))
+	require.False(t, status.Reachable(time.Minute, time.Now().Add(2*time.Minute)))
+
+	close(release)
+	<-done
+
+	status = h.Status()
+	require.True(t, status.PendingSince.IsZero())
+	require.True(t, status.Reachable(time.Minute, time.Now().Add(2*time.Minute)))
+}
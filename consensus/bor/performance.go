@@ -0,0 +1,257 @@
+package bor
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// slotsMeter counts the blocks whose producer has been checked
+	slotsMeter = metrics.NewRegisteredMeter("bor/slots", nil)
+	// missedSlotsMeter counts the blocks which weren't produced by the primary producer
+	missedSlotsMeter = metrics.NewRegisteredMeter("bor/slots/missed", nil)
+	// wiggleHistogram tracks the wiggle delay of out-of-turn blocks, in milliseconds
+	wiggleHistogram = metrics.NewRegisteredHistogram("bor/slots/wiggle", nil, metrics.NewExpDecaySample(1028, 0.015))
+)
+
+// slot is the outcome of a block slot, that is who was expected to produce the
+// block and who did.
+type slot struct {
+	number     uint64
+	primary    common.Address
+	producer   common.Address
+	succession int
+	wiggle     time.Duration
+}
+
+// RecordSlot updates the block production metrics with a block which became
+// canonical. It must be called once per canonical block, not on every
+// verification of its header.
+func (c *Bor) RecordSlot(chain consensus.ChainHeaderReader, header *types.Header) error {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil
+	}
+
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+
+	signer, err := ecrecover(header, c.signatures, c.config)
+	if err != nil {
+		return err
+	}
+
+	succession, err := snap.GetSignerSuccessionNumber(signer)
+	if err != nil {
+		return err
+	}
+
+	recordSlot(slot{
+		number:     number,
+		primary:    snap.ValidatorSet.GetProposer().Address,
+		producer:   signer,
+		succession: succession,
+		wiggle:     wiggleDelay(number, succession, c.config),
+	})
+
+	return nil
+}
+
+// recordSlot updates the block production metrics, globally and per validator
+func recordSlot(s slot) {
+	slotsMeter.Mark(1)
+	metrics.GetOrRegisterCounter(validatorMetric(s.producer, "produced"), nil).Inc(1)
+
+	if s.succession == 0 {
+		return
+	}
+
+	missedSlotsMeter.Mark(1)
+	wiggleHistogram.Update(s.wiggle.Milliseconds())
+	metrics.GetOrRegisterCounter(validatorMetric(s.primary, "missed"), nil).Inc(1)
+	metrics.GetOrRegisterCounter(validatorMetric(s.producer, "backup"), nil).Inc(1)
+}
+
+func validatorMetric(validator common.Address, name string) string {
+	return "bor/validators/" + strings.ToLower(validator.Hex()) + "/" + name
+}
+
+// wiggleDelay returns the delay added to the block time of a block produced
+// succession slots after the primary producer.
+func wiggleDelay(number uint64, succession int, config *params.BorConfig) time.Duration {
+	return time.Duration(succession) * time.Duration(config.CalculateBackupMultiplier(number)) * time.Second
+}
+
+// ValidatorStats is the block production record of a validator over a range of blocks
+type ValidatorStats struct {
+	Address      common.Address `json:"address"`
+	PrimarySlots uint64         `json:"primarySlots"` // blocks the validator was the primary producer of
+	Produced     uint64         `json:"produced"`     // blocks produced by the validator
+	Backup       uint64         `json:"backup"`       // blocks produced in place of another primary producer
+	MissedSlots  uint64         `json:"missedSlots"`  // primary slots produced by another validator
+}
+
+// SprintPerformance is the block production record of a sprint
+type SprintPerformance struct {
+	StartBlock  uint64           `json:"startBlock"`
+	EndBlock    uint64           `json:"endBlock"`
+	Primary     common.Address   `json:"primary"`
+	Producers   []common.Address `json:"producers"` // distinct producers, in order of first block
+	MissedSlots uint64           `json:"missedSlots"`
+	Wiggle      uint64           `json:"wiggle"` // total wiggle delay of the sprint, in seconds
+}
+
+// SpanPerformance is the block production record of a span
+type SpanPerformance struct {
+	ID          uint64            `json:"id"`
+	StartBlock  uint64            `json:"startBlock"`
+	EndBlock    uint64            `json:"endBlock"`
+	MissedSlots uint64            `json:"missedSlots"`
+	Validators  []*ValidatorStats `json:"validators"`
+}
+
+// ValidatorPerformance is the block production record over a range of blocks.
+// Sprints and spans are cut to the range.
+type ValidatorPerformance struct {
+	StartBlock  uint64               `json:"startBlock"`
+	EndBlock    uint64               `json:"endBlock"`
+	MissedSlots uint64               `json:"missedSlots"`
+	Validators  []*ValidatorStats    `json:"validators"`
+	Sprints     []*SprintPerformance `json:"sprints"`
+	Spans       []*SpanPerformance   `json:"spans"`
+}
+
+// validatorStatsSet accumulates the stats of validators
+type validatorStatsSet map[common.Address]*ValidatorStats
+
+func (v validatorStatsSet) add(s slot) {
+	v.get(s.primary).PrimarySlots++
+	v.get(s.producer).Produced++
+
+	if s.succession > 0 {
+		v.get(s.primary).MissedSlots++
+		v.get(s.producer).Backup++
+	}
+}
+
+func (v validatorStatsSet) get(address common.Address) *ValidatorStats {
+	stats, ok := v[address]
+	if !ok {
+		stats = &ValidatorStats{Address: address}
+		v[address] = stats
+	}
+
+	return stats
+}
+
+// sorted returns the stats ordered by validator address
+func (v validatorStatsSet) sorted() []*ValidatorStats {
+	stats := make([]*ValidatorStats, 0, len(v))
+	for _, s := range v {
+		stats = append(stats, s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return bytes.Compare(stats[i].Address.Bytes(), stats[j].Address.Bytes()) < 0
+	})
+
+	return stats
+}
+
+// summarizePerformance aggregates the consecutive slots per validator, sprint
+// and span. Spans may be empty if heimdall isn't available.
+func summarizePerformance(slots []slot, spans []*span.Span, config *params.BorConfig) *ValidatorPerformance {
+	perf := &ValidatorPerformance{
+		Validators: []*ValidatorStats{},
+		Sprints:    []*SprintPerformance{},
+		Spans:      []*SpanPerformance{},
+	}
+
+	if len(slots) == 0 {
+		return perf
+	}
+
+	perf.StartBlock = slots[0].number
+	perf.EndBlock = slots[len(slots)-1].number
+
+	var (
+		total        = make(validatorStatsSet)
+		sprint       *SprintPerformance
+		spanStats    validatorStatsSet
+		spanPerf     *SpanPerformance
+		spanIndex    int
+		seenInSprint map[common.Address]bool
+	)
+
+	for _, s := range slots {
+		if s.succession > 0 {
+			perf.MissedSlots++
+		}
+
+		total.add(s)
+
+		// sprints
+		if sprint == nil || IsSprintStart(s.number, config.CalculateSprint(s.number)) {
+			sprint = &SprintPerformance{StartBlock: s.number, Primary: s.primary, Producers: []common.Address{}}
+			seenInSprint = make(map[common.Address]bool)
+			perf.Sprints = append(perf.Sprints, sprint)
+		}
+
+		sprint.EndBlock = s.number
+
+		if !seenInSprint[s.producer] {
+			seenInSprint[s.producer] = true
+			sprint.Producers = append(sprint.Producers, s.producer)
+		}
+
+		if s.succession > 0 {
+			sprint.MissedSlots++
+			sprint.Wiggle += uint64(s.wiggle / time.Second)
+		}
+
+		// spans
+		for spanIndex < len(spans) && spans[spanIndex].EndBlock < s.number {
+			spanIndex++
+		}
+
+		if spanIndex == len(spans) || spans[spanIndex].StartBlock > s.number {
+			continue
+		}
+
+		if spanPerf == nil || spanPerf.ID != spans[spanIndex].ID {
+			if spanPerf != nil {
+				spanPerf.Validators = spanStats.sorted()
+			}
+
+			spanPerf = &SpanPerformance{ID: spans[spanIndex].ID, StartBlock: s.number}
+			spanStats = make(validatorStatsSet)
+			perf.Spans = append(perf.Spans, spanPerf)
+		}
+
+		spanPerf.EndBlock = s.number
+		spanStats.add(s)
+
+		if s.succession > 0 {
+			spanPerf.MissedSlots++
+		}
+	}
+
+	if spanPerf != nil {
+		spanPerf.Validators = spanStats.sorted()
+	}
+
+	perf.Validators = total.sorted()
+
+	return perf
+}
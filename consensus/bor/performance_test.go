@@ -0,0 +1,87 @@
+package bor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestSummarizePerformance(t *testing.T) {
+	t.Parallel()
+
+	var (
+		config = &params.BorConfig{Sprint: map[string]uint64{"0": 4}, BackupMultiplier: map[string]uint64{"0": 2}}
+		val1   = common.HexToAddress("0x1")
+		val2   = common.HexToAddress("0x2")
+		val3   = common.HexToAddress("0x3")
+	)
+
+	newSlot := func(number uint64, primary, producer common.Address, succession int) slot {
+		return slot{
+			number:     number,
+			primary:    primary,
+			producer:   producer,
+			succession: succession,
+			wiggle:     wiggleDelay(number, succession, config),
+		}
+	}
+
+	slots := []slot{
+		// end of sprint 0
+		newSlot(2, val1, val1, 0),
+		newSlot(3, val1, val2, 1),
+		// sprint 1, spanning over spans 0 and 1
+		newSlot(4, val2, val2, 0),
+		newSlot(5, val2, val3, 2),
+		newSlot(6, val2, val3, 2),
+		newSlot(7, val2, val2, 0),
+	}
+
+	spans := []*span.Span{
+		{ID: 0, StartBlock: 0, EndBlock: 4},
+		{ID: 1, StartBlock: 5, EndBlock: 10},
+	}
+
+	perf := summarizePerformance(slots, spans, config)
+
+	require.Equal(t, uint64(2), perf.StartBlock)
+	require.Equal(t, uint64(7), perf.EndBlock)
+	require.Equal(t, uint64(3), perf.MissedSlots)
+
+	require.Equal(t, []*ValidatorStats{
+		{Address: val1, PrimarySlots: 2, Produced: 1, MissedSlots: 1},
+		{Address: val2, PrimarySlots: 4, Produced: 3, Backup: 1, MissedSlots: 2},
+		{Address: val3, Produced: 2, Backup: 2},
+	}, perf.Validators)
+
+	require.Equal(t, []*SprintPerformance{
+		{StartBlock: 2, EndBlock: 3, Primary: val1, Producers: []common.Address{val1, val2}, MissedSlots: 1, Wiggle: 2},
+		{StartBlock: 4, EndBlock: 7, Primary: val2, Producers: []common.Address{val2, val3}, MissedSlots: 2, Wiggle: 8},
+	}, perf.Sprints)
+
+	require.Len(t, perf.Spans, 2)
+	require.Equal(t, uint64(0), perf.Spans[0].ID)
+	require.Equal(t, uint64(2), perf.Spans[0].StartBlock)
+	require.Equal(t, uint64(4), perf.Spans[0].EndBlock)
+	require.Equal(t, uint64(1), perf.Spans[0].MissedSlots)
+	require.Equal(t, uint64(1), perf.Spans[1].ID)
+	require.Equal(t, uint64(5), perf.Spans[1].StartBlock)
+	require.Equal(t, uint64(7), perf.Spans[1].EndBlock)
+	require.Equal(t, uint64(2), perf.Spans[1].MissedSlots)
+	require.Equal(t, []*ValidatorStats{
+		{Address: val2, PrimarySlots: 3, Produced: 1, MissedSlots: 2},
+		{Address: val3, Produced: 2, Backup: 2},
+	}, perf.Spans[1].Validators)
+
+	// without heimdall there are no spans
+	perf = summarizePerformance(slots, nil, config)
+	require.Empty(t, perf.Spans)
+	require.Len(t, perf.Sprints, 2)
+
+	require.Equal(t, 4*time.Second, wiggleDelay(10, 2, config))
+}
@@ -0,0 +1,107 @@
+// Package remotesigner provides sign functions for the bor consensus engine
+// which sign block headers through an external signer, keeping the validator key
+// off the node.
+package remotesigner
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// Clef signs through the account_signData method of clef, over HTTP or IPC
+	Clef = "clef"
+
+	// Web3Signer signs through the eth1 sign endpoint of Web3Signer
+	Web3Signer = "web3signer"
+
+	web3SignerTimeout = 10 * time.Second
+)
+
+// SignFn signs data on behalf of an account, see bor.SignerFn
+type SignFn = func(account accounts.Account, mimeType string, data []byte) ([]byte, error)
+
+// New returns a sign function backed by the remote signer of the given kind
+// reachable at endpoint.
+func New(kind string, endpoint string) (SignFn, error) {
+	switch kind {
+	case Clef:
+		signer, err := external.NewExternalSigner(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to clef: %w", err)
+		}
+
+		return signer.SignData, nil
+	case Web3Signer:
+		return newWeb3Signer(endpoint).signData, nil
+	default:
+		return nil, fmt.Errorf("unknown remote signer type %q, should be %s or %s", kind, Clef, Web3Signer)
+	}
+}
+
+// web3Signer signs data through the Web3Signer eth1 sign endpoint, which
+// signs the keccak256 hash of the data
+type web3Signer struct {
+	endpoint string
+	client   *http.Client
+}
+
+func newWeb3Signer(endpoint string) *web3Signer {
+	return &web3Signer{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   &http.Client{Timeout: web3SignerTimeout},
+	}
+}
+
+type web3SignRequest struct {
+	Data hexutil.Bytes `json:"data"`
+}
+
+func (s *web3Signer) signData(account accounts.Account, _ string, data []byte) ([]byte, error) {
+	body, err := json.Marshal(&web3SignRequest{Data: data})
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/api/v1/eth1/sign/%s", s.endpoint, account.Address.Hex())
+
+	res, err := s.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("web3signer responded with status %d: %s", res.StatusCode, strings.TrimSpace(string(resBody)))
+	}
+
+	signature, err := hexutil.Decode(strings.TrimSpace(string(resBody)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from web3signer: %w", err)
+	}
+
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("invalid signature length from web3signer: %d", len(signature))
+	}
+
+	// Transform V from 27/28 to 0/1 for Bor use
+	if signature[64] == 27 || signature[64] == 28 {
+		signature[64] -= 27
+	}
+
+	return signature, nil
+}
@@ -0,0 +1,70 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestWeb3Signer(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/eth1/sign/"+address.Hex() {
+			http.Error(w, "unknown identifier", http.StatusNotFound)
+			return
+		}
+
+		var req web3SignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		signature, err := crypto.Sign(crypto.Keccak256(req.Data), key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		// web3signer returns V in the 27/28 form
+		signature[64] += 27
+
+		_, _ = w.Write([]byte(hexutil.Encode(signature)))
+	}))
+	defer server.Close()
+
+	signFn, err := New(Web3Signer, server.URL+"/")
+	require.NoError(t, err)
+
+	data := []byte("bor header")
+
+	signature, err := signFn(accounts.Account{Address: address}, accounts.MimetypeBor, data)
+	require.NoError(t, err)
+
+	pubkey, err := crypto.Ecrecover(crypto.Keccak256(data), signature)
+	require.NoError(t, err)
+
+	signer, err := crypto.UnmarshalPubkey(pubkey)
+	require.NoError(t, err)
+	require.Equal(t, address, crypto.PubkeyToAddress(*signer))
+
+	// unknown accounts are refused
+	_, err = signFn(accounts.Account{}, accounts.MimetypeBor, data)
+	require.Error(t, err)
+
+	_, err = New("keystore", server.URL)
+	require.Error(t, err)
+}
@@ -0,0 +1,29 @@
+package bor
+
+import (
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// signProtected signs the header, unless the signer already signed a different
+// block at the same height or a block above it. The last signed block of each
+// signer is persisted, so that the protection holds across restarts.
+func (c *Bor) signProtected(currentSigner *signer, header *types.Header) error {
+	c.signLock.Lock()
+	defer c.signLock.Unlock()
+
+	number := header.Number.Uint64()
+	sealHash := SealHash(header, c.config)
+
+	if last := rawdb.ReadBorLastSigned(c.db, currentSigner.signer); last != nil {
+		if last.Number > number || (last.Number == number && last.SealHash != sealHash) {
+			return &DoubleSignError{Number: number, LastSigned: last.Number, Signer: currentSigner.signer.Bytes()}
+		}
+	}
+
+	// record the block before signing it, so that a failure in between can't
+	// lead to signing another block at the same height
+	rawdb.WriteBorLastSigned(c.db, currentSigner.signer, &rawdb.LastSignedEntry{Number: number, SealHash: sealHash})
+
+	return Sign(currentSigner.signFn, currentSigner.signer, header, c.config)
+}
@@ -0,0 +1,59 @@
+package bor
+
+import (
+	"math/big"
+	"testing"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestSignProtected(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	db := rawdb.NewMemoryDatabase()
+
+	currentSigner := &signer{
+		signer: crypto.PubkeyToAddress(key.PublicKey),
+		signFn: func(_ accounts.Account, _ string, data []byte) ([]byte, error) {
+			return crypto.Sign(crypto.Keccak256(data), key)
+		},
+		protected: true,
+	}
+
+	newHeader := func(number int64, gasUsed uint64) *types.Header {
+		return &types.Header{Number: big.NewInt(number), GasUsed: gasUsed, Extra: make([]byte, extraVanity+extraSeal)}
+	}
+
+	c := &Bor{config: &params.BorConfig{}, db: db}
+
+	header := newHeader(10, 1)
+	require.NoError(t, c.signProtected(currentSigner, header))
+
+	signatures, _ := lru.NewARC(inmemorySignatures)
+
+	author, err := ecrecover(header, signatures, c.config)
+	require.NoError(t, err)
+	require.Equal(t, currentSigner.signer, author)
+
+	// the same block can be signed again
+	require.NoError(t, c.signProtected(currentSigner, newHeader(10, 1)))
+
+	// but not another block at the same height, or below
+	require.IsType(t, &DoubleSignError{}, c.signProtected(currentSigner, newHeader(10, 2)))
+	require.IsType(t, &DoubleSignError{}, c.signProtected(currentSigner, newHeader(9, 1)))
+
+	// the protection holds across restarts
+	c = &Bor{config: &params.BorConfig{}, db: db}
+	require.IsType(t, &DoubleSignError{}, c.signProtected(currentSigner, newHeader(10, 2)))
+	require.NoError(t, c.signProtected(currentSigner, newHeader(11, 2)))
+}
@@ -33,6 +33,7 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 
@@ -453,8 +454,26 @@ func NewParallelBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainCon
 }
 
 func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (types.Receipts, []*types.Log, uint64, *state.StateDB, error) {
+	return bc.processBlock(context.Background(), block, parent)
+}
+
+// processBlock executes the block on top of its parent state, tracing the
+// execution as part of the span of the context if any.
+func (bc *BlockChain) processBlock(ctx context.Context, block *types.Block, parent *types.Header) (_ types.Receipts, _ []*types.Log, _ uint64, _ *state.StateDB, err error) {
+	ctx, span := tracing.StartSpan(ctx, "blockchain.ProcessBlock")
+	defer func() {
+		tracing.RecordError(span, err)
+		tracing.EndSpan(span)
+	}()
+
+	tracing.SetAttributes(
+		span,
+		attribute.Int("number", int(block.NumberU64())),
+		attribute.Int("number of txs", len(block.Transactions())),
+	)
+
 	// Process the block using processor and parallelProcessor at the same time, take the one which finishes first, cancel the other, and return the result
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	type Result struct {
@@ -464,6 +483,7 @@ func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (ty
 		err      error
 		statedb  *state.StateDB
 		counter  metrics.Counter
+		parallel bool
 	}
 
 	resultChan := make(chan Result, 2)
@@ -481,7 +501,7 @@ func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (ty
 		go func() {
 			parallelStatedb.StartPrefetcher("chain")
 			receipts, logs, usedGas, err := bc.parallelProcessor.Process(block, parallelStatedb, bc.vmConfig, ctx)
-			resultChan <- Result{receipts, logs, usedGas, err, parallelStatedb, blockExecutionParallelCounter}
+			resultChan <- Result{receipts, logs, usedGas, err, parallelStatedb, blockExecutionParallelCounter, true}
 		}()
 	}
 
@@ -496,7 +516,7 @@ func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (ty
 		go func() {
 			statedb.StartPrefetcher("chain")
 			receipts, logs, usedGas, err := bc.processor.Process(block, statedb, bc.vmConfig, ctx)
-			resultChan <- Result{receipts, logs, usedGas, err, statedb, blockExecutionSerialCounter}
+			resultChan <- Result{receipts, logs, usedGas, err, statedb, blockExecutionSerialCounter, false}
 		}()
 	}
 
@@ -515,6 +535,12 @@ func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (ty
 
 	result.counter.Inc(1)
 
+	tracing.SetAttributes(
+		span,
+		attribute.Bool("parallel", result.parallel),
+		attribute.Int("gas used", int(result.usedGas)),
+	)
+
 	// Make sure we are not leaking any prefetchers
 	if processorCount == 2 {
 		go func() {
@@ -738,6 +764,7 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, root common.Hash, repair bo
 			rawdb.DeleteReceipts(db, hash, num)
 			rawdb.DeleteBorReceipt(db, hash, num)
 			rawdb.DeleteBorTxLookupEntry(db, hash, num)
+			rawdb.DeleteBorStateSyncData(db, hash, num)
 		}
 		// Todo(rjl493456442) txlookup, bloombits, etc
 	}
@@ -1383,6 +1410,11 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 		}
 	}
 
+	// Write the state-sync events committed in the block, for inspection over RPC
+	if stateSyncData := state.StateSyncData(); len(stateSyncData) > 0 {
+		rawdb.WriteBorStateSyncData(blockBatch, block.Hash(), block.NumberU64(), stateSyncData)
+	}
+
 	rawdb.WritePreimages(blockBatch, state.Preimages())
 	if err := blockBatch.Write(); err != nil {
 		log.Crit("Failed to write block into disk", "err", err)
@@ -1636,6 +1668,15 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals, setHead bool)
 		return 0, nil
 	}
 
+	insertCtx, insertSpan := tracing.StartSpan(tracing.WithTracer(context.Background(), otel.GetTracerProvider().Tracer("BlockChain")), "blockchain.insertChain")
+	defer tracing.EndSpan(insertSpan)
+
+	tracing.SetAttributes(
+		insertSpan,
+		attribute.Int("first number", int(chain[0].NumberU64())),
+		attribute.Int("number of blocks", len(chain)),
+	)
+
 	// Start a parallel signature recovery (signer will fluke on fork transition, minimal perf loss)
 	senderCacher.recoverFromBlocks(types.MakeSigner(bc.chainConfig, chain[0].Number()), chain)
 
@@ -1873,7 +1914,7 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals, setHead bool)
 
 		// Process block using the parent state as reference point
 		substart := time.Now()
-		receipts, logs, usedGas, statedb, err := bc.ProcessBlock(block, parent)
+		receipts, logs, usedGas, statedb, err := bc.processBlock(insertCtx, block, parent)
 		activeState = statedb
 		if err != nil {
 			bc.reportBlock(block, receipts, err)
@@ -1901,7 +1942,13 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals, setHead bool)
 
 		// Validate the state using the default validator
 		substart = time.Now()
-		if err := bc.validator.ValidateState(block, statedb, receipts, usedGas); err != nil {
+		tracing.Exec(insertCtx, "", "blockchain.ValidateState", func(_ context.Context, span trace.Span) {
+			tracing.SetAttributes(span, attribute.Int("number", int(block.NumberU64())))
+
+			err = bc.validator.ValidateState(block, statedb, receipts, usedGas)
+			tracing.RecordError(span, err)
+		})
+		if err != nil {
 			bc.reportBlock(block, receipts, err)
 			atomic.StoreUint32(&followupInterrupt, 1)
 			return it.index, err
@@ -1918,9 +1965,13 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals, setHead bool)
 		var status WriteStatus
 		if !setHead {
 			// Don't set the head, only insert the block
-			_, err = bc.writeBlockWithState(block, receipts, logs, statedb)
+			tracing.Exec(insertCtx, "", "blockchain.writeBlockWithState", func(_ context.Context, span trace.Span) {
+				tracing.SetAttributes(span, attribute.Int("number", int(block.NumberU64())))
+
+				_, err = bc.writeBlockWithState(block, receipts, logs, statedb)
+			})
 		} else {
-			status, err = bc.writeBlockAndSetHead(context.Background(), block, receipts, logs, statedb, false)
+			status, err = bc.writeBlockAndSetHead(insertCtx, block, receipts, logs, statedb, false)
 		}
 		atomic.StoreUint32(&followupInterrupt, 1)
 		if err != nil {
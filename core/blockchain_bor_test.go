@@ -136,3 +136,43 @@ func TestChain2HeadEvent(t *testing.T) {
 		}})
 
 }
+
+// Tests that the state-sync events of a block are stored from its own state, and
+// not from the ones last committed by any other block processed meanwhile.
+func TestWriteBlockStateSyncData(t *testing.T) {
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		gspec   = &Genesis{Config: params.TestChainConfig}
+		genesis = gspec.MustCommit(db)
+	)
+
+	blockchain, _ := NewBlockChain(db, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	defer blockchain.Stop()
+
+	blocks, _ := GenerateChain(gspec.Config, genesis, ethash.NewFaker(), rawdb.NewMemoryDatabase(), 1, func(i int, gen *BlockGen) {})
+	block := blocks[0]
+
+	statedb, err := blockchain.StateAt(genesis.Root())
+	if err != nil {
+		t.Fatalf("failed to open state: %v", err)
+	}
+	receipts, logs, _, err := blockchain.Processor().Process(block, statedb, vm.Config{}, nil)
+	if err != nil {
+		t.Fatalf("failed to process block: %v", err)
+	}
+
+	stateSyncData := []*types.StateSyncData{{ID: 1, Contract: common.HexToAddress("0x1001"), Data: "01"}}
+	statedb.SetStateSyncData(stateSyncData)
+
+	// Events committed by another block, e.g. by the miner or a debug re-execution
+	blockchain.SetStateSync([]*types.StateSyncData{{ID: 2, Contract: common.HexToAddress("0x1002"), Data: "02"}})
+
+	if _, err := blockchain.writeBlockWithState(block, receipts, logs, statedb); err != nil {
+		t.Fatalf("failed to write block: %v", err)
+	}
+
+	stored := rawdb.ReadBorStateSyncData(db, block.Hash(), block.NumberU64())
+	if len(stored) != 1 || stored[0].ID != 1 || stored[0].Data != "01" {
+		t.Fatalf("state-sync events mismatch: have %+v, want %+v", stored, stateSyncData)
+	}
+}
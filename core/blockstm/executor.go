@@ -37,6 +37,8 @@ type ExecVersionView struct {
 	sender common.Address
 }
 
+// NumSpeculativeProcs is the maximum number of speculative workers of a block,
+// the actual number is chosen for each block.
 var NumSpeculativeProcs int = 8
 
 func SetProcs(specProcs int) {
@@ -170,6 +172,11 @@ type ParallelExecutionResult struct {
 	Stats   *map[int]ExecutionStat
 	Deps    *DAG
 	AllDeps map[int]map[int]bool
+
+	// Incarnations and Aborts are the number of re-executions and dependency
+	// aborts of each transaction, only set when profiling
+	Incarnations []int
+	Aborts       []int
 }
 
 const numGoProcs = 1
@@ -243,6 +250,9 @@ type ParallelExecutor struct {
 	// Enable profiling
 	profile bool
 
+	// Number of speculative workers executing the block
+	numSpeculativeProcs int
+
 	// Worker wait group
 	workerWg sync.WaitGroup
 }
@@ -300,6 +310,7 @@ func NewParallelExecutor(tasks []ExecTask, profile bool, metadata bool) *Paralle
 // nolint: gocognit
 func (pe *ParallelExecutor) Prepare() error {
 	prevSenderTx := make(map[common.Address]int)
+	deps := make(map[int][]int, len(pe.tasks))
 
 	for i, t := range pe.tasks {
 		clearPendingFlag := false
@@ -312,6 +323,7 @@ func (pe *ParallelExecutor) Prepare() error {
 				clearPendingFlag = true
 
 				pe.execTasks.addDependencies(val, i)
+				deps[i] = append(deps[i], val)
 			}
 
 			if clearPendingFlag {
@@ -323,16 +335,21 @@ func (pe *ParallelExecutor) Prepare() error {
 			if tx, ok := prevSenderTx[t.Sender()]; ok {
 				pe.execTasks.addDependencies(tx, i)
 				pe.execTasks.clearPending(i)
+				deps[i] = append(deps[i], tx)
 			}
 
 			prevSenderTx[t.Sender()] = i
 		}
 	}
 
-	pe.workerWg.Add(NumSpeculativeProcs + numGoProcs)
+	// Size the worker pool after the width of the dependency graph and the abort rate of the recent blocks
+	pe.numSpeculativeProcs = speculativeProcs(dagWidth(len(pe.tasks), deps), getRecentAbortRate(), NumSpeculativeProcs)
+	procsGauge.Update(int64(pe.numSpeculativeProcs))
+
+	pe.workerWg.Add(pe.numSpeculativeProcs + numGoProcs)
 
 	// Launch workers that execute transactions
-	for i := 0; i < NumSpeculativeProcs+numGoProcs; i++ {
+	for i := 0; i < pe.numSpeculativeProcs+numGoProcs; i++ {
 		go func(procNum int) {
 			defer pe.workerWg.Done()
 
@@ -366,7 +383,7 @@ func (pe *ParallelExecutor) Prepare() error {
 				}
 			}
 
-			if procNum < NumSpeculativeProcs {
+			if procNum < pe.numSpeculativeProcs {
 				for range pe.chSpeculativeTasks {
 					doWork(pe.specTaskQueue.Pop().(ExecVersionView))
 				}
@@ -554,16 +571,20 @@ func (pe *ParallelExecutor) Step(res *ExecResult) (result ParallelExecutionResul
 
 		pe.Close(true)
 
-		var allDeps map[int]map[int]bool
+		updateRecentAbortRate(len(pe.tasks), pe.cntExec)
 
-		var deps DAG
+		result = ParallelExecutionResult{TxIO: pe.lastTxIO, Stats: &pe.stats, Deps: &DAG{}}
 
 		if pe.profile {
-			allDeps = GetDep(*pe.lastTxIO)
-			deps = BuildDAG(*pe.lastTxIO)
+			deps := BuildDAG(*pe.lastTxIO)
+
+			result.Deps = &deps
+			result.AllDeps = GetDep(*pe.lastTxIO)
+			result.Incarnations = pe.txIncarnations
+			result.Aborts = pe.diagExecAbort
 		}
 
-		return ParallelExecutionResult{pe.lastTxIO, &pe.stats, &deps, allDeps}, err
+		return result, err
 	}
 
 	// Send the next immediate pending transaction to be executed
@@ -599,7 +620,7 @@ type PropertyCheck func(*ParallelExecutor) error
 
 func executeParallelWithCheck(tasks []ExecTask, profile bool, check PropertyCheck, metadata bool, interruptCtx context.Context) (result ParallelExecutionResult, err error) {
 	if len(tasks) == 0 {
-		return ParallelExecutionResult{MakeTxnInputOutput(len(tasks)), nil, nil, nil}, nil
+		return ParallelExecutionResult{TxIO: MakeTxnInputOutput(len(tasks))}, nil
 	}
 
 	pe := NewParallelExecutor(tasks, profile, metadata)
@@ -0,0 +1,92 @@
+package blockstm
+
+import (
+	"math"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// procsGauge is the number of speculative workers chosen for the last block
+var procsGauge = metrics.NewRegisteredGauge("blockstm/procs", nil)
+
+// abortRateDecay is the weight of the previous blocks in the recent abort rate
+const abortRateDecay = 0.8
+
+var (
+	// recentAbortRate is the moving average of the share of executions which
+	// were re-executions, over the recently executed blocks
+	recentAbortRate   float64
+	recentAbortRateMu sync.Mutex
+)
+
+func getRecentAbortRate() float64 {
+	recentAbortRateMu.Lock()
+	defer recentAbortRateMu.Unlock()
+
+	return recentAbortRate
+}
+
+// updateRecentAbortRate accounts the executions of a block in the recent abort rate
+func updateRecentAbortRate(numTasks int, numExecs int) {
+	if numExecs == 0 {
+		return
+	}
+
+	rate := float64(numExecs-numTasks) / float64(numExecs)
+	if rate < 0 {
+		rate = 0
+	}
+
+	recentAbortRateMu.Lock()
+	defer recentAbortRateMu.Unlock()
+
+	recentAbortRate = abortRateDecay*recentAbortRate + (1-abortRateDecay)*rate
+}
+
+// dagWidth returns the maximum number of transactions which may execute
+// concurrently given their dependencies, that is the size of the largest set
+// of transactions at the same depth of the dependency graph.
+func dagWidth(numTasks int, deps map[int][]int) int {
+	depth := make([]int, numTasks)
+	count := make(map[int]int)
+	width := 0
+
+	for i := 0; i < numTasks; i++ {
+		for _, dep := range deps[i] {
+			if dep >= 0 && dep < i && depth[dep]+1 > depth[i] {
+				depth[i] = depth[dep] + 1
+			}
+		}
+
+		count[depth[i]]++
+
+		if count[depth[i]] > width {
+			width = count[depth[i]]
+		}
+	}
+
+	return width
+}
+
+// speculativeProcs returns the number of speculative workers to execute a
+// block, up to maxProcs. A worker is always executing the next transaction,
+// so speculative workers are only useful for the other transactions of the
+// widest level of the graph, and wasted on transactions which will abort.
+func speculativeProcs(width int, abortRate float64, maxProcs int) int {
+	if maxProcs <= 1 {
+		return maxProcs
+	}
+
+	procs := int(math.Ceil(float64(width-numGoProcs) * (1 - abortRate)))
+
+	if procs < 1 {
+		return 1
+	}
+
+	if procs > maxProcs {
+		return maxProcs
+	}
+
+	return procs
+}
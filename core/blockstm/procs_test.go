@@ -0,0 +1,37 @@
+package blockstm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDagWidth(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 0, dagWidth(0, nil))
+	require.Equal(t, 4, dagWidth(4, nil))
+
+	// a chain of transactions
+	require.Equal(t, 1, dagWidth(3, map[int][]int{1: {0}, 2: {1}}))
+
+	// 1, 2 and 3 depend on 0, 4 depends on 3
+	require.Equal(t, 3, dagWidth(5, map[int][]int{1: {0}, 2: {0}, 3: {0}, 4: {3}}))
+}
+
+func TestSpeculativeProcs(t *testing.T) {
+	t.Parallel()
+
+	// a worker always executes the next transaction
+	require.Equal(t, 1, speculativeProcs(1, 0, 8))
+	require.Equal(t, 4, speculativeProcs(5, 0, 8))
+	require.Equal(t, 8, speculativeProcs(100, 0, 8))
+
+	// aborts reduce the number of workers
+	require.Equal(t, 2, speculativeProcs(5, 0.5, 8))
+	require.Equal(t, 1, speculativeProcs(100, 1, 8))
+
+	// the configured number of workers is kept if it can't be reduced
+	require.Equal(t, 1, speculativeProcs(100, 0, 1))
+	require.Equal(t, 0, speculativeProcs(100, 0, 0))
+}
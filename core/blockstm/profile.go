@@ -0,0 +1,160 @@
+package blockstm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TxProfile is the parallel execution record of a transaction
+type TxProfile struct {
+	Index        int           `json:"index"`
+	Hash         common.Hash   `json:"hash"`
+	GasUsed      uint64        `json:"gasUsed"`
+	Incarnations int           `json:"incarnations"` // number of executions of the transaction
+	Aborts       int           `json:"aborts"`       // executions aborted on a dependency
+	Dependencies []int         `json:"dependencies"` // transactions this one reads from
+	Duration     time.Duration `json:"duration"`     // duration of the last execution
+}
+
+// Profile is the parallel execution record of a block. The critical path is
+// the chain of dependent transactions using the most gas, which bounds the
+// speedup of parallel over serial execution.
+type Profile struct {
+	BlockNumber     uint64       `json:"blockNumber"`
+	BlockHash       common.Hash  `json:"blockHash"`
+	Transactions    []*TxProfile `json:"transactions"`
+	CriticalPath    []int        `json:"criticalPath"`
+	CriticalPathGas uint64       `json:"criticalPathGas"`
+	TotalGas        uint64       `json:"totalGas"`
+	Speedup         float64      `json:"speedup"`
+}
+
+// NewProfile builds the profile of a block from the result of its parallel
+// execution with profiling enabled. hashes and gasUsed are indexed by transaction.
+func NewProfile(result ParallelExecutionResult, hashes []common.Hash, gasUsed []uint64) *Profile {
+	profile := &Profile{
+		Transactions: make([]*TxProfile, len(hashes)),
+		CriticalPath: []int{},
+	}
+
+	var (
+		pathGas = make([]uint64, len(hashes))
+		prev    = make([]int, len(hashes))
+		last    = -1
+	)
+
+	for i := range hashes {
+		tx := &TxProfile{
+			Index:        i,
+			Hash:         hashes[i],
+			GasUsed:      gasUsed[i],
+			Incarnations: 1,
+			Dependencies: []int{},
+		}
+
+		if i < len(result.Incarnations) {
+			tx.Incarnations += result.Incarnations[i]
+		}
+
+		if i < len(result.Aborts) {
+			tx.Aborts = result.Aborts[i]
+		}
+
+		if result.Stats != nil {
+			if stat, ok := (*result.Stats)[i]; ok {
+				tx.Duration = time.Duration(stat.End - stat.Start)
+			}
+		}
+
+		for dep := range result.AllDeps[i] {
+			tx.Dependencies = append(tx.Dependencies, dep)
+		}
+
+		sort.Ints(tx.Dependencies)
+
+		// dependencies always have a lower index, their path is already known
+		prev[i] = -1
+
+		for _, dep := range tx.Dependencies {
+			if pathGas[dep] > pathGas[i] {
+				pathGas[i] = pathGas[dep]
+				prev[i] = dep
+			}
+		}
+
+		pathGas[i] += gasUsed[i]
+
+		if last == -1 || pathGas[i] > pathGas[last] {
+			last = i
+		}
+
+		profile.Transactions[i] = tx
+		profile.TotalGas += gasUsed[i]
+	}
+
+	if last == -1 {
+		return profile
+	}
+
+	for i := last; i != -1; i = prev[i] {
+		profile.CriticalPath = append(profile.CriticalPath, i)
+	}
+
+	// Reverse the path so the transactions are in the ascending order
+	for i, j := 0, len(profile.CriticalPath)-1; i < j; i, j = i+1, j-1 {
+		profile.CriticalPath[i], profile.CriticalPath[j] = profile.CriticalPath[j], profile.CriticalPath[i]
+	}
+
+	profile.CriticalPathGas = pathGas[last]
+
+	if profile.CriticalPathGas > 0 {
+		profile.Speedup = float64(profile.TotalGas) / float64(profile.CriticalPathGas)
+	}
+
+	return profile
+}
+
+// DOT renders the dependency graph of the block in the graphviz format, with
+// the critical path highlighted.
+func (p *Profile) DOT() string {
+	// onPath maps the transactions of the critical path to their predecessor on it
+	onPath := make(map[int]int, len(p.CriticalPath))
+	for i, tx := range p.CriticalPath {
+		onPath[tx] = -1
+		if i > 0 {
+			onPath[tx] = p.CriticalPath[i-1]
+		}
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph \"block %d\" {\n", p.BlockNumber)
+
+	for _, tx := range p.Transactions {
+		attrs := fmt.Sprintf("label=\"%d\\ngas %d\\nincarnations %d\"", tx.Index, tx.GasUsed, tx.Incarnations)
+		if _, ok := onPath[tx.Index]; ok {
+			attrs += " color=red"
+		}
+
+		fmt.Fprintf(&b, "  %d [%s];\n", tx.Index, attrs)
+	}
+
+	for _, tx := range p.Transactions {
+		for _, dep := range tx.Dependencies {
+			attrs := ""
+			if prev, ok := onPath[tx.Index]; ok && prev == dep {
+				attrs = " [color=red]"
+			}
+
+			fmt.Fprintf(&b, "  %d -> %d%s;\n", dep, tx.Index, attrs)
+		}
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
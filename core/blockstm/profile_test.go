@@ -0,0 +1,48 @@
+package blockstm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestNewProfile(t *testing.T) {
+	t.Parallel()
+
+	hashes := []common.Hash{{0x1}, {0x2}, {0x3}, {0x4}}
+	gasUsed := []uint64{21000, 50000, 30000, 40000}
+
+	// 2 depends on 0 and 1, 3 is independent
+	result := ParallelExecutionResult{
+		Stats:        &map[int]ExecutionStat{1: {TxIdx: 1, Start: 10, End: 30}},
+		AllDeps:      map[int]map[int]bool{1: {}, 2: {0: true, 1: true}, 3: {}},
+		Incarnations: []int{0, 0, 2, 0},
+		Aborts:       []int{0, 0, 1, 0},
+	}
+
+	profile := NewProfile(result, hashes, gasUsed)
+
+	require.Len(t, profile.Transactions, 4)
+	require.Equal(t, []int{0, 1}, profile.Transactions[2].Dependencies)
+	require.Equal(t, 3, profile.Transactions[2].Incarnations)
+	require.Equal(t, 1, profile.Transactions[2].Aborts)
+	require.Equal(t, 1, profile.Transactions[0].Incarnations)
+	require.Equal(t, int64(20), profile.Transactions[1].Duration.Nanoseconds())
+
+	require.Equal(t, []int{1, 2}, profile.CriticalPath)
+	require.Equal(t, uint64(80000), profile.CriticalPathGas)
+	require.Equal(t, uint64(141000), profile.TotalGas)
+	require.InDelta(t, 1.7625, profile.Speedup, 1e-9)
+
+	dot := profile.DOT()
+	require.Contains(t, dot, "0 -> 2;")
+	require.Contains(t, dot, "1 -> 2 [color=red];")
+	require.NotContains(t, dot, "-> 3")
+
+	// a block without transactions has no critical path
+	empty := NewProfile(ParallelExecutionResult{}, nil, nil)
+	require.Empty(t, empty.CriticalPath)
+	require.Zero(t, empty.Speedup)
+}
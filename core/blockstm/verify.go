@@ -0,0 +1,102 @@
+package blockstm
+
+import "sort"
+
+// DepsMismatch is the difference between the dependencies declared for the
+// transactions of a block and the ones observed while executing them.
+type DepsMismatch struct {
+	// Missing are the observed dependencies, per transaction, which aren't
+	// implied by the declared ones. They lead to aborts and re-executions.
+	Missing map[int][]int
+	// Extra are the declared dependencies, per transaction, which aren't implied
+	// by the observed ones. They needlessly serialize the execution.
+	Extra map[int][]int
+}
+
+// Empty returns true if the declared dependencies match the observed ones
+func (m DepsMismatch) Empty() bool {
+	return len(m.Missing) == 0 && len(m.Extra) == 0
+}
+
+// Count returns the number of missing and extra dependencies
+func (m DepsMismatch) Count() (missing int, extra int) {
+	for _, deps := range m.Missing {
+		missing += len(deps)
+	}
+
+	for _, deps := range m.Extra {
+		extra += len(deps)
+	}
+
+	return missing, extra
+}
+
+// VerifyDeps compares the dependencies declared for numTx transactions with
+// the ones observed during their execution, as returned by GetDep. Both sides
+// may omit the dependencies implied transitively by others.
+func VerifyDeps(declared map[int][]int, observed map[int]map[int]bool, numTx int) DepsMismatch {
+	mismatch := DepsMismatch{
+		Missing: make(map[int][]int),
+		Extra:   make(map[int][]int),
+	}
+
+	declaredDeps := make([][]int, numTx)
+	observedDeps := make([][]int, numTx)
+
+	for i := 0; i < numTx; i++ {
+		for _, j := range declared[i] {
+			// a transaction can only depend on the previous ones
+			if j < 0 || j >= i {
+				mismatch.Extra[i] = append(mismatch.Extra[i], j)
+				continue
+			}
+
+			declaredDeps[i] = append(declaredDeps[i], j)
+		}
+
+		for j := range observed[i] {
+			observedDeps[i] = append(observedDeps[i], j)
+		}
+
+		sort.Ints(observedDeps[i])
+	}
+
+	declaredClosure := transitiveDeps(declaredDeps)
+	observedClosure := transitiveDeps(observedDeps)
+
+	for i := 0; i < numTx; i++ {
+		for _, j := range observedDeps[i] {
+			if !declaredClosure[i][j] {
+				mismatch.Missing[i] = append(mismatch.Missing[i], j)
+			}
+		}
+
+		for _, j := range declaredDeps[i] {
+			if !observedClosure[i][j] {
+				mismatch.Extra[i] = append(mismatch.Extra[i], j)
+			}
+		}
+	}
+
+	return mismatch
+}
+
+// transitiveDeps returns all the transactions each transaction depends on,
+// directly or not. Dependencies must have a lower index.
+func transitiveDeps(deps [][]int) []map[int]bool {
+	closure := make([]map[int]bool, len(deps))
+
+	for i := range deps {
+		closure[i] = make(map[int]bool)
+
+		for _, j := range deps[i] {
+			closure[i][j] = true
+
+			for k := range closure[j] {
+				closure[i][k] = true
+			}
+		}
+	}
+
+	return closure
+}
@@ -0,0 +1,42 @@
+package blockstm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDeps(t *testing.T) {
+	t.Parallel()
+
+	// 1 and 2 read from 0, 3 reads from 1 and 2
+	observed := map[int]map[int]bool{
+		1: {0: true},
+		2: {0: true},
+		3: {1: true, 2: true},
+	}
+
+	// exact match
+	declared := map[int][]int{1: {0}, 2: {0}, 3: {1, 2}}
+	require.True(t, VerifyDeps(declared, observed, 4).Empty())
+
+	// dependencies implied transitively can be declared or not
+	declared = map[int][]int{1: {0}, 2: {0}, 3: {0, 1, 2}}
+	require.True(t, VerifyDeps(declared, observed, 4).Empty())
+
+	// 3 doesn't declare its dependency on 2, 2 declares an unobserved one on 1
+	declared = map[int][]int{1: {0}, 2: {0, 1}, 3: {1}}
+	mismatch := VerifyDeps(declared, observed, 4)
+	require.False(t, mismatch.Empty())
+	require.Equal(t, map[int][]int{3: {2}}, mismatch.Missing)
+	require.Equal(t, map[int][]int{2: {1}}, mismatch.Extra)
+
+	missing, extra := mismatch.Count()
+	require.Equal(t, 1, missing)
+	require.Equal(t, 1, extra)
+
+	// no dependency declared at all, and forward dependencies
+	mismatch = VerifyDeps(map[int][]int{0: {2}}, observed, 4)
+	require.Equal(t, map[int][]int{1: {0}, 2: {0}, 3: {1, 2}}, mismatch.Missing)
+	require.Equal(t, map[int][]int{0: {2}}, mismatch.Extra)
+}
@@ -17,6 +17,8 @@
 package core
 
 import (
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 )
@@ -24,6 +26,14 @@ import (
 // NewTxsEvent is posted when a batch of transactions enter the transaction pool.
 type NewTxsEvent struct{ Txs []*types.Transaction }
 
+// TxDropEvent is posted when a transaction is dropped from the transaction pool.
+type TxDropEvent struct {
+	Hash       common.Hash  `json:"hash"`
+	Reason     TxDropReason `json:"reason"`
+	Time       time.Time    `json:"time"`
+	ReplacedBy *common.Hash `json:"replacedBy,omitempty"` // Replacing transaction, if replaced
+}
+
 // NewMinedBlockEvent is posted when a block has been imported.
 type NewMinedBlockEvent struct{ Block *types.Block }
 
@@ -235,6 +235,12 @@ func (w *chainValidatorFake) GetCheckpointWhitelist() map[uint64]common.Hash {
 	return nil
 }
 func (w *chainValidatorFake) PurgeCheckpointWhitelist() {}
+
+func (w *chainValidatorFake) ProcessMilestone(endBlockNum uint64, endBlockHash common.Hash) {}
+func (w *chainValidatorFake) GetWhitelistedMilestone() (bool, uint64, common.Hash) {
+	return false, 0, common.Hash{}
+}
+func (w *chainValidatorFake) PurgeMilestone() {}
 func (w *chainValidatorFake) GetCheckpoints(current, sidechainHeader *types.Header, sidechainCheckpoints []*types.Header) (map[uint64]*types.Header, error) {
 	return map[uint64]*types.Header{}, nil
 }
@@ -18,6 +18,7 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -38,6 +39,7 @@ import (
 type ParallelEVMConfig struct {
 	Enable               bool
 	SpeculativeProcesses int
+	VerifyDependencies   bool // Compare the dependencies declared in headers with the execution
 }
 
 // StateProcessor is a basic Processor, which takes care of transitioning
@@ -68,6 +70,7 @@ type ExecutionTask struct {
 	blockHash                  common.Hash
 	tx                         *types.Transaction
 	index                      int
+	indexOffset                int            // Position in the block of the first task, if only part of the block is executed
 	statedb                    *state.StateDB // State database that stores the modified values after tx execution.
 	cleanStateDB               *state.StateDB // A clean copy of the initial statedb. It should not be modified.
 	finalStateDB               *state.StateDB // The final statedb.
@@ -174,7 +177,7 @@ func (task *ExecutionTask) Dependencies() []int {
 }
 
 func (task *ExecutionTask) Settle() {
-	task.finalStateDB.Prepare(task.tx.Hash(), task.index)
+	task.finalStateDB.Prepare(task.tx.Hash(), task.indexOffset+task.index)
 
 	coinbaseBalance := task.finalStateDB.GetBalance(task.coinbase)
 
@@ -254,6 +257,17 @@ func (task *ExecutionTask) Settle() {
 
 var parallelizabilityTimer = metrics.NewRegisteredTimer("block/parallelizability", nil)
 
+var (
+	depsVerifiedMeter = metrics.NewRegisteredMeter("blockstm/deps/verified", nil)
+	depsInvalidMeter  = metrics.NewRegisteredMeter("blockstm/deps/invalid", nil)
+	depsMissingMeter  = metrics.NewRegisteredMeter("blockstm/deps/missing", nil)
+	depsExtraMeter    = metrics.NewRegisteredMeter("blockstm/deps/extra", nil)
+)
+
+// ErrInvalidTxDependency is returned if the transaction dependencies declared
+// in a header don't match the execution of the block.
+var ErrInvalidTxDependency = errors.New("invalid transaction dependencies")
+
 // Process processes the state changes according to the Ethereum rules by running
 // the transaction messages using the statedb and applying any rewards to both
 // the processor (coinbase) and any included uncles.
@@ -261,8 +275,22 @@ var parallelizabilityTimer = metrics.NewRegisteredTimer("block/parallelizability
 // Process returns the receipts and logs accumulated during the process and
 // returns the amount of gas that was used in the process. If any of the
 // transactions failed to execute due to insufficient gas it will return an error.
-// nolint:gocognit
 func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg vm.Config, interruptCtx context.Context) (types.Receipts, []*types.Log, uint64, error) {
+	receipts, allLogs, usedGas, _, err := p.process(block, statedb, cfg, interruptCtx, false)
+
+	return receipts, allLogs, usedGas, err
+}
+
+// Profile processes the block like Process, while recording the execution
+// statistics of each transaction.
+func (p *ParallelStateProcessor) Profile(block *types.Block, statedb *state.StateDB, cfg vm.Config, interruptCtx context.Context) (types.Receipts, blockstm.ParallelExecutionResult, error) {
+	receipts, _, _, result, err := p.process(block, statedb, cfg, interruptCtx, true)
+
+	return receipts, result, err
+}
+
+// nolint:gocognit
+func (p *ParallelStateProcessor) process(block *types.Block, statedb *state.StateDB, cfg vm.Config, interruptCtx context.Context, profile bool) (types.Receipts, []*types.Log, uint64, blockstm.ParallelExecutionResult, error) {
 	blockstm.SetProcs(cfg.ParallelSpeculativeProcesses)
 
 	var (
@@ -299,7 +327,7 @@ func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.Stat
 		msg, err := tx.AsMessage(types.MakeSigner(p.config, header.Number), header.BaseFee)
 		if err != nil {
 			log.Error("error creating message", "err", err)
-			return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
+			return nil, nil, 0, blockstm.ParallelExecutionResult{}, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 		}
 
 		cleansdb := statedb.Copy()
@@ -363,7 +391,6 @@ func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.Stat
 
 	backupStateDB := statedb.Copy()
 
-	profile := false
 	result, err := blockstm.ExecuteParallel(tasks, profile, metadata, interruptCtx)
 
 	if err == nil && profile && result.Deps != nil {
@@ -375,7 +402,9 @@ func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.Stat
 			serialWeight += (*result.Stats)[i].End - (*result.Stats)[i].Start
 		}
 
-		parallelizabilityTimer.Update(time.Duration(serialWeight * 100 / weight))
+		if weight > 0 {
+			parallelizabilityTimer.Update(time.Duration(serialWeight * 100 / weight))
+		}
 	}
 
 	for _, task := range tasks {
@@ -398,20 +427,144 @@ func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.Stat
 				t.totalUsedGas = usedGas
 			}
 
-			_, err = blockstm.ExecuteParallel(tasks, false, metadata, interruptCtx)
+			result, err = blockstm.ExecuteParallel(tasks, profile, metadata, interruptCtx)
 
 			break
 		}
 	}
 
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, 0, result, err
+	}
+
+	strict := p.config.Bor != nil && p.config.Bor.IsStrictTxDependency(blockNumber)
+
+	if metadata && (cfg.ParallelVerifyDependencies || strict) {
+		if err := verifyTxDependency(block, deps, result.TxIO); err != nil && strict {
+			return nil, nil, 0, result, err
+		}
 	}
 
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
 	p.engine.Finalize(p.bc, header, statedb, block.Transactions(), block.Uncles())
 
-	return receipts, allLogs, *usedGas, nil
+	return receipts, allLogs, *usedGas, result, nil
+}
+
+// verifyTxDependency compares the transaction dependencies declared in the
+// header with the ones observed from the reads and writes of the transactions
+// during the execution of the block.
+func verifyTxDependency(block *types.Block, declared map[int][]int, txIO *blockstm.TxnInputOutput) error {
+	depsVerifiedMeter.Mark(1)
+
+	mismatch := blockstm.VerifyDeps(declared, blockstm.GetDep(*txIO), len(block.Transactions()))
+	if mismatch.Empty() {
+		return nil
+	}
+
+	missing, extra := mismatch.Count()
+
+	depsInvalidMeter.Mark(1)
+	depsMissingMeter.Mark(int64(missing))
+	depsExtraMeter.Mark(int64(extra))
+
+	log.Warn("Transaction dependencies don't match the execution", "number", block.Number(), "hash", block.Hash(), "missing", missing, "extra", extra)
+	log.Debug("Mismatching transaction dependencies", "number", block.Number(), "missing", mismatch.Missing, "extra", mismatch.Extra)
+
+	return fmt.Errorf("%w: %d missing, %d extra", ErrInvalidTxDependency, missing, extra)
+}
+
+// ErrParallelFeeDelay is returned by ApplyTransactionsParallel if a transaction
+// reads the balance of the coinbase or of the burnt contract, in which case the
+// fees can't be delayed and the transactions should be applied sequentially.
+var ErrParallelFeeDelay = errors.New("transactions depend on delayed fees")
+
+// ParallelApplyResult is the outcome of applying transactions with block-stm
+type ParallelApplyResult struct {
+	State    *state.StateDB           // State after applying the transactions
+	Receipts types.Receipts           // Receipts of the transactions, in order
+	Logs     []*types.Log             // Logs of the transactions, in order
+	UsedGas  uint64                   // Gas used in the block after applying the transactions
+	TxIO     *blockstm.TxnInputOutput // Reads and writes of the transactions, indexed from 0
+}
+
+// ApplyTransactionsParallel applies the transactions in order on top of a copy
+// of statedb, executing them speculatively in parallel with block-stm. The
+// transactions are placed in the block from index txIndex, after usedGas was
+// already used. The given statedb is never modified, and an error is returned
+// if any of the transactions can't be applied.
+func ApplyTransactionsParallel(config *params.ChainConfig, bc *BlockChain, author *common.Address, header *types.Header, statedb *state.StateDB, txs types.Transactions, txIndex int, usedGas uint64, cfg vm.Config, interruptCtx context.Context) (*ParallelApplyResult, error) {
+	if cfg.ParallelSpeculativeProcesses > 0 {
+		blockstm.SetProcs(cfg.ParallelSpeculativeProcesses)
+	}
+
+	var (
+		receipts          types.Receipts
+		allLogs           []*types.Log
+		totalUsedGas      = usedGas
+		shouldDelayFeeCal = true
+		signer            = types.MakeSigner(config, header.Number)
+		blockHash         = header.Hash()
+		blockContext      = NewEVMBlockContext(header, bc, author)
+		tasks             = make([]blockstm.ExecTask, 0, len(txs))
+	)
+
+	// the settled state must not be tracked by any multi-version hashmap
+	finalState := statedb.Copy()
+	finalState.SetMVHashmap(nil)
+
+	for i, tx := range txs {
+		msg, err := tx.AsMessage(signer, header.BaseFee)
+		if err != nil {
+			return nil, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
+		}
+
+		if msg.From() == *author {
+			shouldDelayFeeCal = false
+		}
+
+		tasks = append(tasks, &ExecutionTask{
+			msg:               msg,
+			config:            config,
+			gasLimit:          header.GasLimit,
+			blockNumber:       header.Number,
+			blockHash:         blockHash,
+			tx:                tx,
+			index:             i,
+			indexOffset:       txIndex,
+			cleanStateDB:      statedb.Copy(),
+			finalStateDB:      finalState,
+			blockChain:        bc,
+			header:            header,
+			evmConfig:         cfg,
+			shouldDelayFeeCal: &shouldDelayFeeCal,
+			sender:            msg.From(),
+			totalUsedGas:      &totalUsedGas,
+			receipts:          &receipts,
+			allLogs:           &allLogs,
+			coinbase:          *author,
+			blockContext:      blockContext,
+		})
+	}
+
+	result, err := blockstm.ExecuteParallel(tasks, false, false, interruptCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range tasks {
+		if task.(*ExecutionTask).shouldRerunWithoutFeeDelay {
+			return nil, ErrParallelFeeDelay
+		}
+	}
+
+	return &ParallelApplyResult{
+		State:    finalState,
+		Receipts: receipts,
+		Logs:     allLogs,
+		UsedGas:  totalUsedGas,
+		TxIO:     result.TxIO,
+	}, nil
 }
 
 func GetDeps(txDependency [][]uint64) map[int][]int {
@@ -0,0 +1,54 @@
+package rawdb
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	// borCheckpointWhitelistKey tracks the whitelisted checkpoints, oldest first
+	borCheckpointWhitelistKey = []byte("matic-bor-checkpoint-whitelist")
+)
+
+// CheckpointWhitelistEntry is the end block of a whitelisted checkpoint
+type CheckpointWhitelistEntry struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// ReadBorCheckpointWhitelist retrieves the whitelisted checkpoints, oldest first.
+func ReadBorCheckpointWhitelist(db ethdb.KeyValueReader) []CheckpointWhitelistEntry {
+	data, _ := db.Get(borCheckpointWhitelistKey)
+	if len(data) == 0 {
+		return nil
+	}
+
+	var entries []CheckpointWhitelistEntry
+	if err := rlp.DecodeBytes(data, &entries); err != nil {
+		log.Error("Invalid checkpoint whitelist RLP", "err", err)
+		return nil
+	}
+
+	return entries
+}
+
+// WriteBorCheckpointWhitelist stores the whitelisted checkpoints, oldest first.
+func WriteBorCheckpointWhitelist(db ethdb.KeyValueWriter, entries []CheckpointWhitelistEntry) {
+	bytes, err := rlp.EncodeToBytes(entries)
+	if err != nil {
+		log.Crit("Failed to encode checkpoint whitelist", "err", err)
+	}
+
+	if err := db.Put(borCheckpointWhitelistKey, bytes); err != nil {
+		log.Crit("Failed to store checkpoint whitelist", "err", err)
+	}
+}
+
+// DeleteBorCheckpointWhitelist removes the whitelisted checkpoints.
+func DeleteBorCheckpointWhitelist(db ethdb.KeyValueWriter) {
+	if err := db.Delete(borCheckpointWhitelistKey); err != nil {
+		log.Crit("Failed to delete checkpoint whitelist", "err", err)
+	}
+}
@@ -0,0 +1,128 @@
+package rawdb
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	// heimdallSpanPrefix + span id (uint64 big endian) -> heimdall span
+	heimdallSpanPrefix = []byte(heimdallSpanPrefixStr)
+
+	// heimdallEventPrefix + state id (uint64 big endian) -> heimdall event record
+	heimdallEventPrefix = []byte(heimdallEventPrefixStr)
+
+	// heimdallCheckpointPrefix + checkpoint number (uint64 big endian) -> heimdall checkpoint
+	heimdallCheckpointPrefix = []byte(heimdallCheckpointPrefixStr)
+
+	// heimdallEventCoverageKey tracks the latest state id and to-time up to which
+	// the cached event records are known to be complete.
+	heimdallEventCoverageKey = []byte("matic-heimdall-event-coverage")
+)
+
+const (
+	heimdallSpanPrefixStr       = "matic-heimdall-span-"
+	heimdallEventPrefixStr      = "matic-heimdall-event-"
+	heimdallCheckpointPrefixStr = "matic-heimdall-checkpoint-"
+)
+
+// heimdallSpanKey = heimdallSpanPrefix + span id (uint64 big endian)
+func heimdallSpanKey(spanID uint64) []byte {
+	return append(heimdallSpanPrefix, encodeBlockNumber(spanID)...)
+}
+
+// heimdallEventKey = heimdallEventPrefix + state id (uint64 big endian)
+func heimdallEventKey(stateID uint64) []byte {
+	return append(heimdallEventPrefix, encodeBlockNumber(stateID)...)
+}
+
+// heimdallCheckpointKey = heimdallCheckpointPrefix + checkpoint number (uint64 big endian)
+func heimdallCheckpointKey(number uint64) []byte {
+	return append(heimdallCheckpointPrefix, encodeBlockNumber(number)...)
+}
+
+// ReadHeimdallSpan retrieves the encoded heimdall span with the given id.
+func ReadHeimdallSpan(db ethdb.KeyValueReader, spanID uint64) []byte {
+	data, _ := db.Get(heimdallSpanKey(spanID))
+	return data
+}
+
+// WriteHeimdallSpan stores the encoded heimdall span with the given id.
+func WriteHeimdallSpan(db ethdb.KeyValueWriter, spanID uint64, data []byte) {
+	if err := db.Put(heimdallSpanKey(spanID), data); err != nil {
+		log.Crit("Failed to store heimdall span", "err", err)
+	}
+}
+
+// DeleteHeimdallSpan removes the heimdall span with the given id.
+func DeleteHeimdallSpan(db ethdb.KeyValueWriter, spanID uint64) {
+	if err := db.Delete(heimdallSpanKey(spanID)); err != nil {
+		log.Crit("Failed to delete heimdall span", "err", err)
+	}
+}
+
+// ReadHeimdallEventRecord retrieves the encoded state-sync event record with the given state id.
+func ReadHeimdallEventRecord(db ethdb.KeyValueReader, stateID uint64) []byte {
+	data, _ := db.Get(heimdallEventKey(stateID))
+	return data
+}
+
+// WriteHeimdallEventRecord stores the encoded state-sync event record with the given state id.
+func WriteHeimdallEventRecord(db ethdb.KeyValueWriter, stateID uint64, data []byte) {
+	if err := db.Put(heimdallEventKey(stateID), data); err != nil {
+		log.Crit("Failed to store heimdall event record", "err", err)
+	}
+}
+
+// DeleteHeimdallEventRecord removes the state-sync event record with the given state id.
+func DeleteHeimdallEventRecord(db ethdb.KeyValueWriter, stateID uint64) {
+	if err := db.Delete(heimdallEventKey(stateID)); err != nil {
+		log.Crit("Failed to delete heimdall event record", "err", err)
+	}
+}
+
+// ReadHeimdallEventCoverage retrieves the latest state id and the to-time (unix
+// seconds) up to which the cached event records are complete. The boolean is
+// false if nothing has been recorded yet.
+func ReadHeimdallEventCoverage(db ethdb.KeyValueReader) (uint64, int64, bool) {
+	data, _ := db.Get(heimdallEventCoverageKey)
+	if len(data) != 16 {
+		return 0, 0, false
+	}
+
+	return binary.BigEndian.Uint64(data[:8]), int64(binary.BigEndian.Uint64(data[8:])), true
+}
+
+// WriteHeimdallEventCoverage stores the latest state id and the to-time (unix
+// seconds) up to which the cached event records are complete.
+func WriteHeimdallEventCoverage(db ethdb.KeyValueWriter, stateID uint64, to int64) {
+	data := make([]byte, 16)
+	binary.BigEndian.PutUint64(data[:8], stateID)
+	binary.BigEndian.PutUint64(data[8:], uint64(to))
+
+	if err := db.Put(heimdallEventCoverageKey, data); err != nil {
+		log.Crit("Failed to store heimdall event coverage", "err", err)
+	}
+}
+
+// ReadHeimdallCheckpoint retrieves the encoded heimdall checkpoint with the given number.
+func ReadHeimdallCheckpoint(db ethdb.KeyValueReader, number uint64) []byte {
+	data, _ := db.Get(heimdallCheckpointKey(number))
+	return data
+}
+
+// WriteHeimdallCheckpoint stores the encoded heimdall checkpoint with the given number.
+func WriteHeimdallCheckpoint(db ethdb.KeyValueWriter, number uint64, data []byte) {
+	if err := db.Put(heimdallCheckpointKey(number), data); err != nil {
+		log.Crit("Failed to store heimdall checkpoint", "err", err)
+	}
+}
+
+// DeleteHeimdallCheckpoint removes the heimdall checkpoint with the given number.
+func DeleteHeimdallCheckpoint(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Delete(heimdallCheckpointKey(number)); err != nil {
+		log.Crit("Failed to delete heimdall checkpoint", "err", err)
+	}
+}
@@ -0,0 +1,52 @@
+package rawdb
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	// borLastSignedPrefix + signer -> last block signed by the signer
+	borLastSignedPrefix = []byte("matic-bor-last-signed-")
+)
+
+// LastSignedEntry is the last block header signed by a block producer
+type LastSignedEntry struct {
+	Number   uint64
+	SealHash common.Hash
+}
+
+// borLastSignedKey = borLastSignedPrefix + signer
+func borLastSignedKey(signer common.Address) []byte {
+	return append(append([]byte{}, borLastSignedPrefix...), signer.Bytes()...)
+}
+
+// ReadBorLastSigned retrieves the last block signed by the signer, if any.
+func ReadBorLastSigned(db ethdb.KeyValueReader, signer common.Address) *LastSignedEntry {
+	data, _ := db.Get(borLastSignedKey(signer))
+	if len(data) == 0 {
+		return nil
+	}
+
+	entry := new(LastSignedEntry)
+	if err := rlp.DecodeBytes(data, entry); err != nil {
+		log.Error("Invalid last signed block RLP", "signer", signer, "err", err)
+		return nil
+	}
+
+	return entry
+}
+
+// WriteBorLastSigned stores the last block signed by the signer.
+func WriteBorLastSigned(db ethdb.KeyValueWriter, signer common.Address, entry *LastSignedEntry) {
+	bytes, err := rlp.EncodeToBytes(entry)
+	if err != nil {
+		log.Crit("Failed to encode last signed block", "err", err)
+	}
+
+	if err := db.Put(borLastSignedKey(signer), bytes); err != nil {
+		log.Crit("Failed to store last signed block", "err", err)
+	}
+}
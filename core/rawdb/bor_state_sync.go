@@ -0,0 +1,54 @@
+package rawdb
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	// borStateSyncPrefix + num (uint64 big endian) + hash -> state-sync events committed in the block
+	borStateSyncPrefix = []byte("matic-bor-state-sync-")
+)
+
+// borStateSyncKey = borStateSyncPrefix + num (uint64 big endian) + hash
+func borStateSyncKey(number uint64, hash common.Hash) []byte {
+	return append(append(borStateSyncPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// ReadBorStateSyncData retrieves the state-sync events committed in a block.
+func ReadBorStateSyncData(db ethdb.KeyValueReader, hash common.Hash, number uint64) []*types.StateSyncData {
+	data, _ := db.Get(borStateSyncKey(number, hash))
+	if len(data) == 0 {
+		return nil
+	}
+
+	var stateSyncData []*types.StateSyncData
+	if err := rlp.DecodeBytes(data, &stateSyncData); err != nil {
+		log.Error("Invalid state-sync data RLP", "hash", hash, "err", err)
+		return nil
+	}
+
+	return stateSyncData
+}
+
+// WriteBorStateSyncData stores the state-sync events committed in a block.
+func WriteBorStateSyncData(db ethdb.KeyValueWriter, hash common.Hash, number uint64, stateSyncData []*types.StateSyncData) {
+	bytes, err := rlp.EncodeToBytes(stateSyncData)
+	if err != nil {
+		log.Crit("Failed to encode state-sync data", "err", err)
+	}
+
+	if err := db.Put(borStateSyncKey(number, hash), bytes); err != nil {
+		log.Crit("Failed to store state-sync data", "err", err)
+	}
+}
+
+// DeleteBorStateSyncData removes the state-sync events committed in a block.
+func DeleteBorStateSyncData(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
+	if err := db.Delete(borStateSyncKey(number, hash)); err != nil {
+		log.Crit("Failed to delete state-sync data", "err", err)
+	}
+}
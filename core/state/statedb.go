@@ -103,6 +103,9 @@ type StateDB struct {
 	logs    map[common.Hash][]*types.Log
 	logSize uint
 
+	// Bor state-sync events committed in the block, persisted along with it
+	stateSyncData []*types.StateSyncData
+
 	preimages map[common.Hash][]byte
 
 	// Per-transaction access list
@@ -498,6 +501,18 @@ func (s *StateDB) Logs() []*types.Log {
 	return logs
 }
 
+// SetStateSyncData records the state-sync events committed in the block the
+// state is processed for.
+func (s *StateDB) SetStateSyncData(stateSyncData []*types.StateSyncData) {
+	s.stateSyncData = stateSyncData
+}
+
+// StateSyncData returns the state-sync events committed in the block the state
+// is processed for.
+func (s *StateDB) StateSyncData() []*types.StateSyncData {
+	return s.stateSyncData
+}
+
 // AddPreimage records a SHA3 preimage seen by the VM.
 func (s *StateDB) AddPreimage(hash common.Hash, preimage []byte) {
 	if _, ok := s.preimages[hash]; !ok {
@@ -1033,6 +1048,7 @@ func (s *StateDB) Copy() *StateDB {
 		refund:              s.refund,
 		logs:                make(map[common.Hash][]*types.Log, len(s.logs)),
 		logSize:             s.logSize,
+		stateSyncData:       s.stateSyncData,
 		preimages:           make(map[common.Hash][]byte, len(s.preimages)),
 		journal:             newJournal(),
 		hasher:              crypto.NewKeccakState(),
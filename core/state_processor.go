@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core/blockstm"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -66,7 +67,14 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 		blockNumber = block.Number()
 		allLogs     []*types.Log
 		gp          = new(GasPool).AddGas(block.GasLimit())
+		reads       [][]blockstm.ReadDescriptor
+		writes      [][]blockstm.WriteDescriptor
 	)
+	// Once the transaction dependencies declared in the header must match the
+	// execution, the reads and writes of the transactions are recorded to verify
+	// them, so that the block is rejected whichever processor executes it
+	strict := header.TxDependency != nil && p.config.Bor != nil && p.config.Bor.IsStrictTxDependency(blockNumber)
+
 	// Mutate the block and state according to any hard-fork specs
 	if p.config.DAOForkSupport && p.config.DAOForkBlock != nil && p.config.DAOForkBlock.Cmp(block.Number()) == 0 {
 		misc.ApplyDAOHardFork(statedb)
@@ -88,13 +96,31 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 			return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 		}
 		statedb.Prepare(tx.Hash(), i)
+		if strict {
+			statedb.AddEmptyMVHashMap()
+		}
 		receipt, err := applyTransaction(msg, p.config, p.bc, nil, gp, statedb, blockNumber, blockHash, tx, usedGas, vmenv, interruptCtx)
 		if err != nil {
 			return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 		}
+		if strict {
+			reads = append(reads, statedb.MVReadList())
+			writes = append(writes, statedb.MVFullWriteList())
+			statedb.ClearReadMap()
+			statedb.ClearWriteMap()
+		}
 		receipts = append(receipts, receipt)
 		allLogs = append(allLogs, receipt.Logs...)
 	}
+	if strict {
+		txIO := blockstm.MakeTxnInputOutput(len(block.Transactions()))
+		txIO.RecordReadAtOnce(reads)
+		txIO.RecordAllWriteAtOnce(writes)
+
+		if err := verifyTxDependency(block, GetDeps(header.TxDependency), txIO); err != nil {
+			return nil, nil, 0, err
+		}
+	}
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
 	p.engine.Finalize(p.bc, header, statedb, block.Transactions(), block.Uncles())
 
@@ -18,6 +18,7 @@ package core
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -311,6 +312,86 @@ func TestStateProcessorErrors(t *testing.T) {
 	}
 }
 
+// TestStrictTxDependency tests that once the transaction dependencies declared
+// in the header must match the execution, the blocks declaring wrong ones are
+// rejected by the serial processor as well as by the parallel one.
+func TestStrictTxDependency(t *testing.T) {
+	var (
+		config = &params.ChainConfig{
+			ChainID:             big.NewInt(1),
+			HomesteadBlock:      big.NewInt(0),
+			EIP150Block:         big.NewInt(0),
+			EIP155Block:         big.NewInt(0),
+			EIP158Block:         big.NewInt(0),
+			ByzantiumBlock:      big.NewInt(0),
+			ConstantinopleBlock: big.NewInt(0),
+			PetersburgBlock:     big.NewInt(0),
+			IstanbulBlock:       big.NewInt(0),
+			MuirGlacierBlock:    big.NewInt(0),
+			BerlinBlock:         big.NewInt(0),
+			LondonBlock:         big.NewInt(0),
+			Ethash:              new(params.EthashConfig),
+			Bor: &params.BorConfig{
+				BurntContract:           map[string]string{"0": "0x000000000000000000000000000000000000dead"},
+				StrictTxDependencyBlock: big.NewInt(0),
+			},
+		}
+		signer  = types.LatestSigner(config)
+		key1, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		key2, _ = crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+		gspec   = &Genesis{
+			Config: config,
+			Alloc: GenesisAlloc{
+				crypto.PubkeyToAddress(key1.PublicKey): {Balance: big.NewInt(1000000000000000000)},
+				crypto.PubkeyToAddress(key2.PublicKey): {Balance: big.NewInt(1000000000000000000)},
+			},
+		}
+		gendb   = rawdb.NewMemoryDatabase()
+		genesis = gspec.MustCommit(gendb)
+	)
+	var makeTx = func(key *ecdsa.PrivateKey, nonce uint64, to common.Address) *types.Transaction {
+		tx, _ := types.SignTx(types.NewTransaction(nonce, to, big.NewInt(1), params.TxGas, big.NewInt(875000000), nil), signer, key)
+		return tx
+	}
+	// The third transaction is sent by the sender of the first one
+	makeBlock := func(deps [][]uint64) *types.Block {
+		blocks, _ := GenerateChain(config, genesis, ethash.NewFaker(), gendb, 1, func(i int, b *BlockGen) {
+			b.AddTx(makeTx(key1, 0, common.Address{0x01}))
+			b.AddTx(makeTx(key2, 0, common.Address{0x02}))
+			b.AddTx(makeTx(key1, 1, common.Address{0x03}))
+			b.header.TxDependency = deps
+		})
+		return blocks[0]
+	}
+	var (
+		valid   = makeBlock([][]uint64{{}, {}, {0}})
+		invalid = []*types.Block{
+			makeBlock([][]uint64{{}, {}, {}}),   // missing dependency
+			makeBlock([][]uint64{{}, {0}, {0}}), // extra dependency
+		}
+	)
+	for _, parallel := range []bool{false, true} {
+		db := rawdb.NewMemoryDatabase()
+		gspec.MustCommit(db)
+
+		var blockchain *BlockChain
+		if parallel {
+			blockchain, _ = NewParallelBlockChain(db, nil, config, ethash.NewFaker(), vm.Config{ParallelEnable: true, ParallelSpeculativeProcesses: 8}, nil, nil, nil)
+		} else {
+			blockchain, _ = NewBlockChain(db, nil, config, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+		}
+		for i, block := range invalid {
+			if _, err := blockchain.InsertChain(types.Blocks{block}); !errors.Is(err, ErrInvalidTxDependency) {
+				t.Errorf("parallel %v, block %d: have error %v, want %v", parallel, i, err, ErrInvalidTxDependency)
+			}
+		}
+		if _, err := blockchain.InsertChain(types.Blocks{valid}); err != nil {
+			t.Errorf("parallel %v: failed to insert valid block: %v", parallel, err)
+		}
+		blockchain.Stop()
+	}
+}
+
 // GenerateBadBlock constructs a "block" which contains the transactions. The transactions are not expected to be
 // valid, and no proper post-state can be made. But from the perspective of the blockchain, the block is sufficiently
 // valid to be considered for import:
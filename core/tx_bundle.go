@@ -0,0 +1,224 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// maxBundles is the maximum number of bundles kept by the pool
+	maxBundles = 1024
+
+	// maxAccountBundles is the maximum number of bundles kept by the pool with
+	// transactions from a single account
+	maxAccountBundles = 16
+
+	// maxBundleBlocks is the maximum number of blocks ahead of the head a bundle
+	// can target
+	maxBundleBlocks = 100
+)
+
+var (
+	// ErrEmptyBundle is returned if a bundle doesn't contain any transaction.
+	ErrEmptyBundle = errors.New("empty bundle")
+
+	// ErrBundleExpired is returned if a bundle targets a block which was
+	// already mined.
+	ErrBundleExpired = errors.New("bundle block number already reached")
+
+	// ErrBundleTooFar is returned if a bundle targets a block too far ahead of
+	// the head.
+	ErrBundleTooFar = errors.New("bundle block number too far ahead")
+
+	// ErrBundleAccountLimit is returned if an account already has transactions
+	// in the maximum number of bundles in the pool.
+	ErrBundleAccountLimit = errors.New("too many bundles from account")
+)
+
+var (
+	bundleGauge        = metrics.NewRegisteredGauge("txpool/bundles", nil)
+	bundleEvictedMeter = metrics.NewRegisteredMeter("txpool/bundles/evicted", nil)
+	bundleExpiredMeter = metrics.NewRegisteredMeter("txpool/bundles/expired", nil)
+)
+
+// Bundle is an ordered list of transactions to be included atomically at the
+// top of a given block, or not at all.
+type Bundle struct {
+	Txs               types.Transactions
+	BlockNumber       uint64        // Block the bundle must be included in
+	MinTimestamp      uint64        // Minimum block timestamp, zero if unbounded
+	MaxTimestamp      uint64        // Maximum block timestamp, zero if unbounded
+	RevertingTxHashes []common.Hash // Transactions allowed to revert without failing the bundle
+}
+
+// Hash returns the hash of the bundle, computed from the hashes of its
+// transactions.
+func (b *Bundle) Hash() common.Hash {
+	hashes := make([]byte, 0, len(b.Txs)*common.HashLength)
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash().Bytes()...)
+	}
+
+	return crypto.Keccak256Hash(hashes)
+}
+
+// CanRevert returns whether the transaction with the given hash is allowed to
+// revert.
+func (b *Bundle) CanRevert(hash common.Hash) bool {
+	for _, h := range b.RevertingTxHashes {
+		if h == hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cheapest returns the cheapest transaction of the bundle according to cmp,
+// which prices the whole bundle.
+func (b *Bundle) cheapest(cmp func(a, b *types.Transaction) int) *types.Transaction {
+	var cheapest *types.Transaction
+
+	for _, tx := range b.Txs {
+		if cheapest == nil || cmp(tx, cheapest) < 0 {
+			cheapest = tx
+		}
+	}
+
+	return cheapest
+}
+
+// pooledBundle is a bundle kept by the pool along with the senders of its
+// transactions.
+type pooledBundle struct {
+	bundle  *Bundle
+	senders []common.Address
+}
+
+// bundles is the section of the pool holding the bundles. They are never
+// announced nor broadcast to peers.
+type bundles struct {
+	lock    sync.RWMutex
+	bundles map[common.Hash]*pooledBundle
+}
+
+func newBundles() *bundles {
+	return &bundles{
+		bundles: make(map[common.Hash]*pooledBundle),
+	}
+}
+
+// add inserts a bundle with transactions from the given distinct senders,
+// failing if one of them has too many bundles already. If the section is full,
+// the cheapest bundle according to cmp is evicted, unless the new one doesn't
+// pay more.
+func (b *bundles) add(bundle *Bundle, senders []common.Address, cmp func(a, b *types.Transaction) int) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	hash := bundle.Hash()
+	if _, ok := b.bundles[hash]; ok {
+		return ErrAlreadyKnown
+	}
+
+	accountBundles := make(map[common.Address]int, len(senders))
+	for _, from := range senders {
+		accountBundles[from] = 0
+	}
+
+	var (
+		cheapest   *pooledBundle
+		cheapestTx *types.Transaction
+	)
+
+	for _, other := range b.bundles {
+		for _, from := range other.senders {
+			if count, ok := accountBundles[from]; ok {
+				accountBundles[from] = count + 1
+			}
+		}
+
+		if tx := other.bundle.cheapest(cmp); cheapest == nil || cmp(tx, cheapestTx) < 0 {
+			cheapest, cheapestTx = other, tx
+		}
+	}
+
+	for _, count := range accountBundles {
+		if count >= maxAccountBundles {
+			return ErrBundleAccountLimit
+		}
+	}
+
+	if len(b.bundles) >= maxBundles {
+		if cmp(bundle.cheapest(cmp), cheapestTx) <= 0 {
+			return ErrUnderpriced
+		}
+
+		delete(b.bundles, cheapest.bundle.Hash())
+		bundleEvictedMeter.Mark(1)
+	}
+
+	b.bundles[hash] = &pooledBundle{bundle: bundle, senders: senders}
+	bundleGauge.Update(int64(len(b.bundles)))
+
+	return nil
+}
+
+// pending returns the bundles which can be included in the block with the
+// given number and timestamp, sorted by decreasing price according to cmp.
+func (b *bundles) pending(number uint64, timestamp uint64, cmp func(a, b *types.Transaction) int) []*Bundle {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	var pending []*Bundle
+
+	for _, pooled := range b.bundles {
+		bundle := pooled.bundle
+		if bundle.BlockNumber != number {
+			continue
+		}
+
+		if bundle.MinTimestamp != 0 && timestamp < bundle.MinTimestamp {
+			continue
+		}
+
+		if bundle.MaxTimestamp != 0 && timestamp > bundle.MaxTimestamp {
+			continue
+		}
+
+		pending = append(pending, bundle)
+	}
+
+	cheapest := make(map[*Bundle]*types.Transaction, len(pending))
+	for _, bundle := range pending {
+		cheapest[bundle] = bundle.cheapest(cmp)
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return cmp(cheapest[pending[i]], cheapest[pending[j]]) > 0
+	})
+
+	return pending
+}
+
+// reset drops the bundles which can't be included after the block with the
+// given number anymore.
+func (b *bundles) reset(number uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for hash, pooled := range b.bundles {
+		if pooled.bundle.BlockNumber <= number {
+			delete(b.bundles, hash)
+			bundleExpiredMeter.Mark(1)
+		}
+	}
+
+	bundleGauge.Update(int64(len(b.bundles)))
+}
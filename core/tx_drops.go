@@ -0,0 +1,118 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// txDropsLimit is the number of recent drops kept by the pool
+const txDropsLimit = 8192
+
+// TxDropReason is the reason a transaction was dropped from the pool.
+type TxDropReason string
+
+const (
+	// TxDropUnderpriced is used for transactions evicted by better paying ones
+	// when the pool is full.
+	TxDropUnderpriced TxDropReason = "underpriced"
+
+	// TxDropReplaced is used for transactions replaced by another one from the
+	// same account with the same nonce and a higher price.
+	TxDropReplaced TxDropReason = "replaced"
+
+	// TxDropNonceTooLow is used for transactions whose nonce was used by another
+	// transaction included in a block.
+	TxDropNonceTooLow TxDropReason = "nonce too low"
+
+	// TxDropInsufficientFunds is used for transactions the account can't pay
+	// for anymore, or over the block gas limit.
+	TxDropInsufficientFunds TxDropReason = "insufficient funds"
+
+	// TxDropAccountSlots is used for transactions over the slots of their
+	// account, when the pool is full.
+	TxDropAccountSlots TxDropReason = "over account slots"
+
+	// TxDropQueueFull is used for the queued transactions of the least recently
+	// active accounts, when the queue is full.
+	TxDropQueueFull TxDropReason = "queue full"
+
+	// TxDropLifetime is used for transactions queued for longer than the pool
+	// lifetime.
+	TxDropLifetime TxDropReason = "lifetime expired"
+)
+
+// txDrops is a bounded ring buffer of the recent drops of the pool, indexed by
+// transaction hash.
+type txDrops struct {
+	lock   sync.RWMutex
+	events []TxDropEvent
+	next   int                 // Position of the next drop in the ring
+	index  map[common.Hash]int // Position of the last drop of each transaction
+	unsent []TxDropEvent       // Drops not sent to the subscribers yet
+}
+
+func newTxDrops(limit int) *txDrops {
+	return &txDrops{
+		events: make([]TxDropEvent, 0, limit),
+		index:  make(map[common.Hash]int),
+	}
+}
+
+// add records the drop of a transaction, overwriting the oldest drop if the
+// buffer is full.
+func (d *txDrops) add(hash common.Hash, reason TxDropReason, replacedBy *common.Hash) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	ev := TxDropEvent{
+		Hash:       hash,
+		Reason:     reason,
+		Time:       time.Now(),
+		ReplacedBy: replacedBy,
+	}
+
+	if len(d.events) < cap(d.events) {
+		d.events = append(d.events, ev)
+	} else {
+		if old := d.events[d.next].Hash; d.index[old] == d.next {
+			delete(d.index, old)
+		}
+
+		d.events[d.next] = ev
+	}
+
+	d.index[hash] = d.next
+	d.next = (d.next + 1) % cap(d.events)
+
+	if len(d.unsent) >= cap(d.events) {
+		d.unsent = d.unsent[1:]
+	}
+
+	d.unsent = append(d.unsent, ev)
+}
+
+// get returns the last drop of the transaction, if still in the buffer.
+func (d *txDrops) get(hash common.Hash) (TxDropEvent, bool) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	pos, ok := d.index[hash]
+	if !ok {
+		return TxDropEvent{}, false
+	}
+
+	return d.events[pos], true
+}
+
+// flush returns the drops recorded since the last flush.
+func (d *txDrops) flush() []TxDropEvent {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	unsent := d.unsent
+	d.unsent = nil
+
+	return unsent
+}
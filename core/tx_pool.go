@@ -176,6 +176,11 @@ type TxPoolConfig struct {
 	Journal   string           // Journal of local transactions to survive node restarts
 	Rejournal time.Duration    // Time interval to regenerate the local transaction journal
 
+	Snapshot             string        // Snapshot of the pending and queued transactions of all accounts, to survive node restarts
+	Resnapshot           time.Duration // Time interval to regenerate the transaction pool snapshot
+	SnapshotAccountSlots uint64        // Maximum number of transactions per account in the snapshot
+	SnapshotGlobalSlots  uint64        // Maximum number of transactions for all accounts in the snapshot
+
 	PriceLimit uint64 // Minimum gas price to enforce for acceptance into the pool
 	PriceBump  uint64 // Minimum price bump percentage to replace an already existing transaction (nonce)
 
@@ -194,6 +199,10 @@ var DefaultTxPoolConfig = TxPoolConfig{
 	Journal:   "transactions.rlp",
 	Rejournal: time.Hour,
 
+	Resnapshot:           10 * time.Minute,
+	SnapshotAccountSlots: 16 + 64,            // account slots + account queue
+	SnapshotGlobalSlots:  4096 + 1024 + 1024, // global slots + global queue
+
 	PriceLimit: 1,
 	PriceBump:  10,
 
@@ -214,6 +223,18 @@ func (config *TxPoolConfig) sanitize() TxPoolConfig {
 		log.Warn("Sanitizing invalid txpool journal time", "provided", conf.Rejournal, "updated", time.Second)
 		conf.Rejournal = time.Second
 	}
+	if conf.Resnapshot < time.Second {
+		log.Warn("Sanitizing invalid txpool snapshot time", "provided", conf.Resnapshot, "updated", time.Second)
+		conf.Resnapshot = time.Second
+	}
+	if conf.SnapshotAccountSlots < 1 {
+		log.Warn("Sanitizing invalid txpool snapshot account slots", "provided", conf.SnapshotAccountSlots, "updated", DefaultTxPoolConfig.SnapshotAccountSlots)
+		conf.SnapshotAccountSlots = DefaultTxPoolConfig.SnapshotAccountSlots
+	}
+	if conf.SnapshotGlobalSlots < 1 {
+		log.Warn("Sanitizing invalid txpool snapshot global slots", "provided", conf.SnapshotGlobalSlots, "updated", DefaultTxPoolConfig.SnapshotGlobalSlots)
+		conf.SnapshotGlobalSlots = DefaultTxPoolConfig.SnapshotGlobalSlots
+	}
 	if conf.PriceLimit < 1 {
 		log.Warn("Sanitizing invalid txpool price limit", "provided", conf.PriceLimit, "updated", DefaultTxPoolConfig.PriceLimit)
 		conf.PriceLimit = DefaultTxPoolConfig.PriceLimit
@@ -260,10 +281,11 @@ type TxPool struct {
 	gasPriceUint *uint256.Int
 	gasPriceMu   sync.RWMutex
 
-	txFeed event.Feed
-	scope  event.SubscriptionScope
-	signer types.Signer
-	mu     sync.RWMutex
+	txFeed   event.Feed
+	dropFeed event.Feed
+	scope    event.SubscriptionScope
+	signer   types.Signer
+	mu       sync.RWMutex
 
 	istanbul bool // Fork indicator whether we are in the istanbul stage.
 	eip2718  bool // Fork indicator whether we are using EIP-2718 type transactions.
@@ -273,8 +295,9 @@ type TxPool struct {
 	pendingNonces *txNoncer      // Pending state tracking virtual nonces
 	currentMaxGas uint64         // Current gas limit for transaction caps
 
-	locals  *accountSet // Set of local transaction to exempt from eviction rules
-	journal *txJournal  // Journal of local transaction to back up to disk
+	locals   *accountSet // Set of local transaction to exempt from eviction rules
+	journal  *txJournal  // Journal of local transaction to back up to disk
+	snapshot *txSnapshot // Snapshot of all the transactions to back up to disk
 
 	pending      map[common.Address]*txList // All currently processable transactions
 	pendingCount int
@@ -283,6 +306,10 @@ type TxPool struct {
 	beats        map[common.Address]time.Time // Last heartbeat from each known account
 	all          *txLookup                    // All transactions to allow lookups
 	priced       *txPricedList                // All transactions sorted by price
+	private      *privateTxs                  // Private transactions, never broadcast
+	bundles      *bundles                     // Bundles of transactions, never broadcast
+	drops        *txDrops                     // Recent drops of transactions, with their reason
+	mined        map[common.Hash]struct{}     // Transactions included by the blocks of the last reset
 
 	chainHeadCh     chan ChainHeadEvent
 	chainHeadSub    event.Subscription
@@ -319,6 +346,9 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		queue:           make(map[common.Address]*txList),
 		beats:           make(map[common.Address]time.Time),
 		all:             newTxLookup(),
+		private:         newPrivateTxs(),
+		bundles:         newBundles(),
+		drops:           newTxDrops(txDropsLimit),
 		chainHeadCh:     make(chan ChainHeadEvent, chainHeadChanSize),
 		reqResetCh:      make(chan *txpoolResetRequest),
 		reqPromoteCh:    make(chan *accountSet),
@@ -359,6 +389,15 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 		}
 	}
 
+	// If the pool snapshot is enabled, refill the pool with the remote transactions
+	if config.Snapshot != "" {
+		pool.snapshot = newTxSnapshot(config.Snapshot, config.SnapshotAccountSlots, config.SnapshotGlobalSlots)
+
+		if err := pool.snapshot.load(pool.AddRemotesSync); err != nil {
+			log.Warn("Failed to load transaction pool snapshot", "err", err)
+		}
+	}
+
 	// Subscribe events from blockchain and start the main event loop.
 	pool.chainHeadSub = pool.chain.SubscribeChainHeadEvent(pool.chainHeadCh)
 	pool.wg.Add(1)
@@ -379,12 +418,14 @@ func (pool *TxPool) loop() {
 		report  = time.NewTicker(statsReportInterval)
 		evict   = time.NewTicker(evictionInterval)
 		journal = time.NewTicker(pool.config.Rejournal)
+		snap    = time.NewTicker(pool.config.Resnapshot)
 		// Track the previous head headers for transaction reorgs
 		head = pool.chain.CurrentBlock()
 	)
 	defer report.Stop()
 	defer evict.Stop()
 	defer journal.Stop()
+	defer snap.Stop()
 
 	// Notify tests that the init phase is done
 	close(pool.initDoneCh)
@@ -448,7 +489,9 @@ func (pool *TxPool) loop() {
 				var hash common.Hash
 
 				for _, hash = range toRemove {
-					pool.removeTx(hash, true)
+					if pool.removeTx(hash, true) > 0 {
+						pool.drops.add(hash, TxDropLifetime, nil)
+					}
 				}
 
 				pool.mu.Unlock()
@@ -463,6 +506,14 @@ func (pool *TxPool) loop() {
 				}
 				pool.mu.Unlock()
 			}
+
+		// Handle transaction pool snapshot regeneration
+		case <-snap.C:
+			if pool.snapshot != nil {
+				if err := pool.snapshot.save(pool.remoteContent()); err != nil {
+					log.Warn("Failed to save transaction pool snapshot", "err", err)
+				}
+			}
 		}
 	}
 }
@@ -479,6 +530,11 @@ func (pool *TxPool) Stop() {
 	if pool.journal != nil {
 		pool.journal.close()
 	}
+	if pool.snapshot != nil {
+		if err := pool.snapshot.save(pool.remoteContent()); err != nil {
+			log.Warn("Failed to save transaction pool snapshot", "err", err)
+		}
+	}
 	log.Info("Transaction pool stopped")
 }
 
@@ -488,6 +544,22 @@ func (pool *TxPool) SubscribeNewTxsEvent(ch chan<- NewTxsEvent) event.Subscripti
 	return pool.scope.Track(pool.txFeed.Subscribe(ch))
 }
 
+// SubscribeDropTxsEvent registers a subscription of TxDropEvent and
+// starts sending event to the given channel.
+func (pool *TxPool) SubscribeDropTxsEvent(ch chan<- TxDropEvent) event.Subscription {
+	return pool.scope.Track(pool.dropFeed.Subscribe(ch))
+}
+
+// DropReason returns the last drop of the given transaction from the pool, or
+// nil if it isn't among the recent drops.
+func (pool *TxPool) DropReason(hash common.Hash) *TxDropEvent {
+	if ev, ok := pool.drops.get(hash); ok {
+		return &ev
+	}
+
+	return nil
+}
+
 // GasPrice returns the current gas price enforced by the transaction pool.
 func (pool *TxPool) GasPrice() *big.Int {
 	pool.gasPriceMu.RLock()
@@ -597,6 +669,19 @@ func (pool *TxPool) Content() (map[common.Address]types.Transactions, map[common
 	return pending, queued
 }
 
+// remoteContent retrieves the data content of the transaction pool like Content,
+// leaving out the transactions of the local accounts.
+func (pool *TxPool) remoteContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	pending, queued := pool.Content()
+
+	for _, addr := range pool.Locals() {
+		delete(pending, addr)
+		delete(queued, addr)
+	}
+
+	return pending, queued
+}
+
 // ContentFrom retrieves the data content of the transaction pool, returning the
 // pending as well as queued transactions of this address, grouped by nonce.
 func (pool *TxPool) ContentFrom(addr common.Address) (types.Transactions, types.Transactions) {
@@ -915,6 +1000,10 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 
 			dropped := pool.removeTx(tx.Hash(), false)
 			pool.changesSinceReorg += dropped
+
+			if dropped > 0 {
+				pool.drops.add(tx.Hash(), TxDropUnderpriced, nil)
+			}
 		}
 	}
 
@@ -939,6 +1028,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (replaced bool, err e
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
 			pendingReplaceMeter.Mark(1)
+			pool.drops.add(old.Hash(), TxDropReplaced, &hash)
 		}
 
 		pool.all.Add(tx, isLocal)
@@ -1010,6 +1100,7 @@ func (pool *TxPool) enqueueTx(hash common.Hash, tx *types.Transaction, local boo
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		queuedReplaceMeter.Mark(1)
+		pool.drops.add(old.Hash(), TxDropReplaced, &hash)
 	} else {
 		// Nothing was replaced, bump the queued counter
 		queuedGauge.Inc(1)
@@ -1083,6 +1174,7 @@ func (pool *TxPool) promoteTx(addr common.Address, hash common.Hash, tx *types.T
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		pendingReplaceMeter.Mark(1)
+		pool.drops.add(old.Hash(), TxDropReplaced, &hash)
 	} else {
 		// Nothing was replaced, bump the pending counter
 		pendingGauge.Inc(1)
@@ -1112,6 +1204,97 @@ func (pool *TxPool) AddLocal(tx *types.Transaction) error {
 	return pool.addTx(tx, !pool.config.NoLocals, true)
 }
 
+// AddPrivate adds a valid transaction to the private section of the pool, from
+// which it is only included by the local block producer, up to the block with
+// the given number. After that block, the transaction is dropped or, if publish
+// is set, added to the pool as a remote transaction.
+func (pool *TxPool) AddPrivate(tx *types.Transaction, maxBlock uint64, publish bool) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	hash := tx.Hash()
+	if pool.all.Get(hash) != nil || pool.private.has(hash) {
+		knownTxMeter.Mark(1)
+		return ErrAlreadyKnown
+	}
+
+	// Private transactions come from RPC users, so they are validated as remote
+	// ones and must pay the minimum gas price
+	if err := pool.validateTx(tx, false); err != nil {
+		invalidTxMeter.Mark(1)
+		return err
+	}
+
+	from, _ := types.Sender(pool.signer, tx) // already validated
+
+	return pool.private.add(&privateTx{tx: tx, from: from, maxBlock: maxBlock, publish: publish}, pool.priced.urgent.cmp)
+}
+
+// PendingPrivate returns the private transactions which can be included in the
+// block with the given number, grouped by account and sorted by nonce.
+func (pool *TxPool) PendingPrivate(number uint64) map[common.Address]types.Transactions {
+	return pool.private.pending(number)
+}
+
+// AddBundle adds a bundle of transactions to be included atomically at the top
+// of the block it targets, one of the next maxBundleBlocks blocks. The
+// transactions are only checked statelessly, as they may depend on each other,
+// the bundle being simulated by the miner.
+func (pool *TxPool) AddBundle(bundle *Bundle) error {
+	if len(bundle.Txs) == 0 {
+		return ErrEmptyBundle
+	}
+
+	pool.mu.RLock()
+	head, maxGas := pool.chain.CurrentBlock().NumberU64(), pool.currentMaxGas
+	pool.mu.RUnlock()
+
+	if bundle.BlockNumber <= head {
+		return ErrBundleExpired
+	}
+
+	if bundle.BlockNumber > head+maxBundleBlocks {
+		return ErrBundleTooFar
+	}
+
+	var (
+		gas     uint64
+		senders []common.Address
+		seen    = make(map[common.Address]struct{})
+	)
+
+	for _, tx := range bundle.Txs {
+		if uint64(tx.Size()) > txMaxSize {
+			return ErrOversizedData
+		}
+
+		from, err := types.Sender(pool.signer, tx)
+		if err != nil {
+			return ErrInvalidSender
+		}
+
+		if _, ok := seen[from]; !ok {
+			seen[from] = struct{}{}
+			senders = append(senders, from)
+		}
+
+		gas += tx.Gas()
+	}
+
+	if gas > maxGas {
+		return ErrGasLimit
+	}
+
+	return pool.bundles.add(bundle, senders, pool.priced.urgent.cmp)
+}
+
+// PendingBundles returns the bundles which can be included in the block with
+// the given number and timestamp, by decreasing price of their cheapest
+// transaction.
+func (pool *TxPool) PendingBundles(number uint64, timestamp uint64) []*Bundle {
+	return pool.bundles.pending(number, timestamp, pool.priced.urgent.cmp)
+}
+
 // AddRemotes enqueues a batch of transactions into the pool if they are valid. If the
 // senders are not among the locally tracked ones, full pricing constraints will apply.
 //
@@ -1352,6 +1535,18 @@ func (pool *TxPool) Get(hash common.Hash) *types.Transaction {
 	return pool.all.Get(hash)
 }
 
+// ConflictHints returns the contracts a pooled transaction is expected to touch,
+// as declared in its access list or observed when executing it.
+func (pool *TxPool) ConflictHints(hash common.Hash) []common.Address {
+	return pool.all.Hints(hash)
+}
+
+// SetConflictHints records the contracts touched by a pooled transaction when
+// executing it, to be used as hints of its conflicts with other transactions.
+func (pool *TxPool) SetConflictHints(hash common.Hash, contracts []common.Address) {
+	pool.all.SetHints(hash, contracts)
+}
+
 // Has returns an indicator whether txpool has a transaction cached with the
 // given hash.
 func (pool *TxPool) Has(hash common.Hash) bool {
@@ -1546,7 +1741,10 @@ func (pool *TxPool) runReorg(ctx context.Context, done chan struct{}, reset *txp
 
 		defer close(done)
 
-		var promoteAddrs []common.Address
+		var (
+			promoteAddrs []common.Address
+			published    types.Transactions
+		)
 
 		tracing.ElapsedTime(ctx, span, "01 dirty accounts flattening", func(_ context.Context, innerSpan trace.Span) {
 			if dirtyAccounts != nil && reset == nil {
@@ -1574,6 +1772,17 @@ func (pool *TxPool) runReorg(ctx context.Context, done chan struct{}, reset *txp
 					pool.reset(reset.oldHead, reset.newHead)
 				})
 
+				head := reset.newHead
+				if head == nil {
+					head = pool.chain.CurrentBlock().Header() // Special case during testing
+				}
+
+				// Drop the private transactions which can't be included anymore
+				published = pool.private.reset(head.Number.Uint64(), pool.currentState)
+
+				// Drop the bundles targeting a block which was already mined
+				pool.bundles.reset(head.Number.Uint64())
+
 				tracing.SetAttributes(
 					innerSpan,
 					attribute.Int("events-reset-head", len(events)),
@@ -1683,6 +1892,11 @@ func (pool *TxPool) runReorg(ctx context.Context, done chan struct{}, reset *txp
 
 		pool.mu.Unlock()
 
+		// Expired private transactions are added as any remote transaction
+		if len(published) > 0 {
+			pool.AddRemotes(published)
+		}
+
 		// Notify subsystems for newly added transactions
 		tracing.ElapsedTime(ctx, span, "13 notify about new transactions", func(_ context.Context, _ trace.Span) {
 			for _, tx := range promoted {
@@ -1707,6 +1921,11 @@ func (pool *TxPool) runReorg(ctx context.Context, done chan struct{}, reset *txp
 				pool.txFeed.Send(NewTxsEvent{txs})
 			})
 		}
+
+		// Notify subsystems for the transactions dropped since the last reorg
+		for _, ev := range pool.drops.flush() {
+			pool.dropFeed.Send(ev)
+		}
 	})
 }
 
@@ -1714,9 +1933,13 @@ func (pool *TxPool) runReorg(ctx context.Context, done chan struct{}, reset *txp
 // of the transaction pool is valid with regard to the chain state.
 func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	// If we're reorging an old state, reinject all dropped transactions
-	var reinject types.Transactions
+	var reinject, included types.Transactions
 
-	if oldHead != nil && oldHead.Hash() != newHead.ParentHash {
+	if oldHead != nil && oldHead.Hash() == newHead.ParentHash {
+		if add := pool.chain.GetBlock(newHead.Hash(), newHead.Number.Uint64()); add != nil {
+			included = add.Transactions()
+		}
+	} else if oldHead != nil {
 		// If the reorg is too deep, avoid doing it (will happen during fast sync)
 		oldNum := oldHead.Number.Uint64()
 		newNum := newHead.Number.Uint64()
@@ -1725,7 +1948,7 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 			log.Debug("Skipping deep transaction reorg", "depth", depth)
 		} else {
 			// Reorg seems shallow enough to pull in all transactions into memory
-			var discarded, included types.Transactions
+			var discarded types.Transactions
 			var (
 				rem = pool.chain.GetBlock(oldHead.Hash(), oldHead.Number.Uint64())
 				add = pool.chain.GetBlock(newHead.Hash(), newHead.Number.Uint64())
@@ -1789,6 +2012,13 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	pool.pendingNonces = newTxNoncer(statedb)
 	pool.currentMaxGas = newHead.GasLimit
 
+	// Remember the transactions included since the old head, which leave the
+	// pool for their nonce without being dropped
+	pool.mined = make(map[common.Hash]struct{}, len(included))
+	for _, tx := range included {
+		pool.mined[tx.Hash()] = struct{}{}
+	}
+
 	// Inject any transactions discarded due to reorgs
 	log.Debug("Reinjecting stale transactions", "count", len(reinject))
 	senderCacher.recover(pool.signer, reinject)
@@ -1837,6 +2067,10 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 		for _, tx := range forwards {
 			hash = tx.Hash()
 			pool.all.Remove(hash)
+
+			if _, ok := pool.mined[hash]; !ok {
+				pool.drops.add(hash, TxDropNonceTooLow, nil)
+			}
 		}
 
 		log.Trace("Removed old queued transactions", "count", forwardsLen)
@@ -1850,6 +2084,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 		for _, tx := range drops {
 			hash = tx.Hash()
 			pool.all.Remove(hash)
+			pool.drops.add(hash, TxDropInsufficientFunds, nil)
 		}
 
 		log.Trace("Removed unpayable queued transactions", "count", dropsLen)
@@ -1877,6 +2112,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 			for _, tx := range caps {
 				hash = tx.Hash()
 				pool.all.Remove(hash)
+				pool.drops.add(hash, TxDropAccountSlots, nil)
 
 				log.Trace("Removed cap-exceeding queued transaction", "hash", hash)
 			}
@@ -1999,6 +2235,7 @@ func (pool *TxPool) truncatePending() {
 						// Drop the transaction from the global pools too
 						hash = tx.Hash()
 						pool.all.Remove(hash)
+						pool.drops.add(hash, TxDropAccountSlots, nil)
 
 						// Update the account nonce to the dropped transaction
 						pool.pendingNonces.setIfLower(offenders[i], tx.Nonce())
@@ -2040,6 +2277,7 @@ func (pool *TxPool) truncatePending() {
 					// Drop the transaction from the global pools too
 					hash = tx.Hash()
 					pool.all.Remove(hash)
+					pool.drops.add(hash, TxDropAccountSlots, nil)
 
 					// Update the account nonce to the dropped transaction
 					pool.pendingNonces.setIfLower(addr, tx.Nonce())
@@ -2112,6 +2350,7 @@ func (pool *TxPool) truncateQueue() {
 
 			for _, tx = range listFlatten {
 				pool.removeTx(tx.Hash(), true)
+				pool.drops.add(tx.Hash(), TxDropQueueFull, nil)
 			}
 
 			drop -= size
@@ -2128,6 +2367,7 @@ func (pool *TxPool) truncateQueue() {
 		txs = listFlatten
 		for i := len(txs) - 1; i >= 0 && drop > 0; i-- {
 			pool.removeTx(txs[i].Hash(), true)
+			pool.drops.add(txs[i].Hash(), TxDropQueueFull, nil)
 
 			drop--
 
@@ -2172,6 +2412,12 @@ func (pool *TxPool) demoteUnexecutables() {
 			hash = tx.Hash()
 			pool.all.Remove(hash)
 			log.Trace("Removed old pending transaction", "hash", hash)
+
+			// Mined transactions aren't recorded as dropped for their nonce,
+			// it's their expected way out of the pool
+			if _, ok := pool.mined[hash]; !ok {
+				pool.drops.add(hash, TxDropNonceTooLow, nil)
+			}
 		}
 
 		// Drop all transactions that are too costly (low balance or out of gas), and queue any invalids back for later
@@ -2186,6 +2432,7 @@ func (pool *TxPool) demoteUnexecutables() {
 			log.Trace("Removed unpayable pending transaction", "hash", hash)
 
 			pool.all.Remove(hash)
+			pool.drops.add(hash, TxDropInsufficientFunds, nil)
 		}
 
 		pendingNofundsMeter.Mark(int64(dropsLen))
@@ -2360,6 +2607,7 @@ type txLookup struct {
 	lock    sync.RWMutex
 	locals  map[common.Hash]*types.Transaction
 	remotes map[common.Hash]*types.Transaction
+	hints   map[common.Hash][]common.Address // Contracts touched by the transactions, if known
 }
 
 // newTxLookup returns a new txLookup structure.
@@ -2367,6 +2615,7 @@ func newTxLookup() *txLookup {
 	return &txLookup{
 		locals:  make(map[common.Hash]*types.Transaction),
 		remotes: make(map[common.Hash]*types.Transaction),
+		hints:   make(map[common.Hash][]common.Address),
 	}
 }
 
@@ -2465,6 +2714,16 @@ func (t *txLookup) Add(tx *types.Transaction, local bool) {
 	} else {
 		t.remotes[tx.Hash()] = tx
 	}
+
+	// the access list is the first hint of the contracts touched by the transaction
+	if accessList := tx.AccessList(); len(accessList) > 0 {
+		contracts := make([]common.Address, 0, len(accessList))
+		for _, tuple := range accessList {
+			contracts = append(contracts, tuple.Address)
+		}
+
+		t.hints[tx.Hash()] = contracts
+	}
 }
 
 // Remove removes a transaction from the lookup.
@@ -2485,6 +2744,27 @@ func (t *txLookup) Remove(hash common.Hash) {
 
 	delete(t.locals, hash)
 	delete(t.remotes, hash)
+	delete(t.hints, hash)
+}
+
+// Hints returns the contracts touched by a transaction, if known.
+func (t *txLookup) Hints(hash common.Hash) []common.Address {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.hints[hash]
+}
+
+// SetHints replaces the contracts touched by a transaction of the lookup.
+func (t *txLookup) SetHints(hash common.Hash, contracts []common.Address) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.locals[hash] == nil && t.remotes[hash] == nil {
+		return
+	}
+
+	t.hints[hash] = contracts
 }
 
 // RemoteToLocals migrates the transactions belongs to the given locals to locals
@@ -26,6 +26,7 @@ import (
 	"math/big"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
@@ -42,9 +43,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/debug"
 	"github.com/ethereum/go-ethereum/common/leak"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
@@ -4679,3 +4682,559 @@ func runWithTimeout(tb testing.TB, fn func(chan struct{}), outerDone chan struct
 		tb.Errorf("[%s] %s timeouted, thread %d, iteration %d. Stack %s", common.NowMilliseconds(), name, thread, n, stack)
 	}
 }
+
+// Tests that the conflict hints of transactions are seeded from their access
+// lists, can be updated and are dropped along with the transactions.
+func TestTransactionConflictHints(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	contract := common.Address{0x02}
+
+	signer := types.LatestSignerForChainID(params.TestChainConfig.ChainID)
+	tx1, _ := types.SignNewTx(key, signer, &types.AccessListTx{
+		ChainID:    params.TestChainConfig.ChainID,
+		Nonce:      0,
+		GasPrice:   big.NewInt(1),
+		Gas:        100000,
+		To:         &common.Address{0x01},
+		Value:      big.NewInt(100),
+		AccessList: types.AccessList{{Address: contract}},
+	})
+	tx2 := transaction(1, 100000, key)
+
+	for _, err := range pool.AddRemotesSync([]*types.Transaction{tx1, tx2}) {
+		if err != nil {
+			t.Fatalf("failed to add transaction: %v", err)
+		}
+	}
+	if hints := pool.ConflictHints(tx1.Hash()); len(hints) != 1 || hints[0] != contract {
+		t.Errorf("access list hints mismatch: have %v, want %v", hints, []common.Address{contract})
+	}
+	if hints := pool.ConflictHints(tx2.Hash()); hints != nil {
+		t.Errorf("unexpected hints for transaction without access list: %v", hints)
+	}
+
+	observed := []common.Address{{0x01}, {0x03}}
+	pool.SetConflictHints(tx2.Hash(), observed)
+
+	if hints := pool.ConflictHints(tx2.Hash()); len(hints) != 2 || hints[1] != observed[1] {
+		t.Errorf("observed hints mismatch: have %v, want %v", hints, observed)
+	}
+
+	// hints of unknown transactions aren't kept
+	unknown := transaction(2, 100000, key)
+	pool.SetConflictHints(unknown.Hash(), observed)
+
+	if hints := pool.ConflictHints(unknown.Hash()); hints != nil {
+		t.Errorf("unexpected hints for unknown transaction: %v", hints)
+	}
+
+	pool.mu.Lock()
+	pool.removeTx(tx2.Hash(), true)
+	pool.mu.Unlock()
+
+	if hints := pool.ConflictHints(tx2.Hash()); hints != nil {
+		t.Errorf("unexpected hints for removed transaction: %v", hints)
+	}
+}
+
+// Tests that private transactions are kept apart from the pool until they
+// expire, when they are dropped or returned to be published.
+func TestPrivateTransactions(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, from, big.NewInt(1000000000))
+
+	tx0, tx1, tx2 := transaction(0, 100000, key), transaction(1, 100000, key), transaction(2, 100000, key)
+
+	if err := pool.AddPrivate(tx1, 10, false); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	if err := pool.AddPrivate(tx0, 20, true); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	if err := pool.AddPrivate(tx0, 20, true); !errors.Is(err, ErrAlreadyKnown) {
+		t.Errorf("expected %v, got %v", ErrAlreadyKnown, err)
+	}
+	if err := pool.AddPrivate(transaction(0, 100, key), 20, true); !errors.Is(err, ErrIntrinsicGas) {
+		t.Errorf("expected %v, got %v", ErrIntrinsicGas, err)
+	}
+	if pool.Get(tx0.Hash()) != nil || pool.Get(tx1.Hash()) != nil {
+		t.Fatalf("private transactions found in the public pool")
+	}
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("unexpected public transactions: pending %d, queued %d", pending, queued)
+	}
+
+	if txs := pool.PendingPrivate(10)[from]; len(txs) != 2 || txs[0] != tx0 || txs[1] != tx1 {
+		t.Errorf("private transactions mismatch at block 10: %v", txs)
+	}
+	if txs := pool.PendingPrivate(11)[from]; len(txs) != 1 || txs[0] != tx0 {
+		t.Errorf("private transactions mismatch at block 11: %v", txs)
+	}
+
+	// the first transaction is dropped once the nonce is used
+	if err := pool.AddPrivate(tx2, 20, false); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+
+	testSetNonce(pool, from, 1)
+
+	pool.mu.Lock()
+	published := pool.private.reset(5, pool.currentState)
+	pool.mu.Unlock()
+
+	if len(published) != 0 {
+		t.Errorf("unexpected published transactions: %v", published)
+	}
+	if pool.private.has(tx0.Hash()) {
+		t.Errorf("private transaction with used nonce not dropped")
+	}
+
+	// only the expired transactions to publish are returned
+	pool.mu.Lock()
+	published = pool.private.reset(20, pool.currentState)
+	pool.mu.Unlock()
+
+	if len(published) != 0 {
+		t.Errorf("unexpected published transactions: %v", published)
+	}
+	if len(pool.PendingPrivate(0)) != 0 {
+		t.Errorf("expired private transactions not dropped")
+	}
+
+	tx3 := transaction(3, 100000, key)
+	if err := pool.AddPrivate(tx3, 30, true); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+
+	pool.mu.Lock()
+	published = pool.private.reset(30, pool.currentState)
+	pool.mu.Unlock()
+
+	if len(published) != 1 || published[0] != tx3 {
+		t.Errorf("published transactions mismatch: %v", published)
+	}
+}
+
+// Tests that bundles are kept apart from the pool, returned for the block and
+// time window they target, and dropped once that block is mined.
+func TestBundles(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	tx0, tx1 := transaction(0, 100000, key), transaction(1, 100000, key)
+
+	bundle := &Bundle{Txs: types.Transactions{tx0, tx1}, BlockNumber: 2, MinTimestamp: 10, MaxTimestamp: 20}
+	if err := pool.AddBundle(bundle); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	if err := pool.AddBundle(bundle); !errors.Is(err, ErrAlreadyKnown) {
+		t.Errorf("expected %v, got %v", ErrAlreadyKnown, err)
+	}
+	if err := pool.AddBundle(&Bundle{BlockNumber: 2}); !errors.Is(err, ErrEmptyBundle) {
+		t.Errorf("expected %v, got %v", ErrEmptyBundle, err)
+	}
+	if err := pool.AddBundle(&Bundle{Txs: types.Transactions{tx0}}); !errors.Is(err, ErrBundleExpired) {
+		t.Errorf("expected %v, got %v", ErrBundleExpired, err)
+	}
+	if err := pool.AddBundle(&Bundle{Txs: types.Transactions{transaction(0, pool.currentMaxGas+1, key)}, BlockNumber: 2}); !errors.Is(err, ErrGasLimit) {
+		t.Errorf("expected %v, got %v", ErrGasLimit, err)
+	}
+	if pending, queued := pool.Stats(); pending != 0 || queued != 0 {
+		t.Fatalf("unexpected public transactions: pending %d, queued %d", pending, queued)
+	}
+
+	if bundles := pool.PendingBundles(2, 15); len(bundles) != 1 || bundles[0] != bundle {
+		t.Errorf("bundles mismatch at block 2: %v", bundles)
+	}
+	if bundles := pool.PendingBundles(3, 15); len(bundles) != 0 {
+		t.Errorf("unexpected bundles at block 3: %v", bundles)
+	}
+	if bundles := pool.PendingBundles(2, 21); len(bundles) != 0 {
+		t.Errorf("unexpected bundles after the max timestamp: %v", bundles)
+	}
+
+	// The bundles are returned by decreasing price of their cheapest transaction
+	pricier := &Bundle{Txs: types.Transactions{pricedTransaction(2, 100000, big.NewInt(2), key)}, BlockNumber: 2}
+	if err := pool.AddBundle(pricier); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	if bundles := pool.PendingBundles(2, 15); len(bundles) != 2 || bundles[0] != pricier || bundles[1] != bundle {
+		t.Errorf("bundles order mismatch at block 2: %v", bundles)
+	}
+
+	pool.bundles.reset(1)
+	if len(pool.PendingBundles(2, 15)) != 2 {
+		t.Errorf("bundles dropped before their block")
+	}
+	pool.bundles.reset(2)
+	if len(pool.PendingBundles(2, 15)) != 0 {
+		t.Errorf("bundles not dropped after their block")
+	}
+}
+
+// Tests that bundles can only target the next blocks, are limited per sender,
+// and that the cheapest ones are evicted once the bundles section is full.
+func TestBundleLimits(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	if err := pool.AddBundle(&Bundle{Txs: types.Transactions{transaction(0, 100000, key)}, BlockNumber: maxBundleBlocks + 1}); !errors.Is(err, ErrBundleTooFar) {
+		t.Errorf("expected %v, got %v", ErrBundleTooFar, err)
+	}
+
+	for i := 0; i < maxAccountBundles; i++ {
+		bundle := &Bundle{Txs: types.Transactions{pricedTransaction(uint64(i), 100000, big.NewInt(3), key)}, BlockNumber: maxBundleBlocks}
+		if err := pool.AddBundle(bundle); err != nil {
+			t.Fatalf("failed to add bundle %d: %v", i, err)
+		}
+	}
+
+	// The bundle counts for all of its senders
+	other, _ := crypto.GenerateKey()
+
+	bundle := &Bundle{Txs: types.Transactions{
+		pricedTransaction(0, 100000, big.NewInt(3), other),
+		pricedTransaction(maxAccountBundles, 100000, big.NewInt(3), key),
+	}, BlockNumber: 1}
+	if err := pool.AddBundle(bundle); !errors.Is(err, ErrBundleAccountLimit) {
+		t.Errorf("expected %v, got %v", ErrBundleAccountLimit, err)
+	}
+
+	// Fill the bundles section, the first bundle paying less than the others
+	var cheapest *Bundle
+
+	for len(pool.bundles.bundles) < maxBundles {
+		key, _ := crypto.GenerateKey()
+
+		for i := 0; i < maxAccountBundles; i++ {
+			price := big.NewInt(3)
+			if cheapest == nil {
+				price = big.NewInt(2)
+			}
+			bundle := &Bundle{Txs: types.Transactions{pricedTransaction(uint64(i), 100000, price, key)}, BlockNumber: 1}
+			if err := pool.AddBundle(bundle); err != nil {
+				t.Fatalf("failed to add bundle: %v", err)
+			}
+			if cheapest == nil {
+				cheapest = bundle
+			}
+		}
+	}
+
+	if err := pool.AddBundle(&Bundle{Txs: types.Transactions{pricedTransaction(0, 100000, big.NewInt(2), other)}, BlockNumber: 1}); !errors.Is(err, ErrUnderpriced) {
+		t.Errorf("expected %v, got %v", ErrUnderpriced, err)
+	}
+	if err := pool.AddBundle(&Bundle{Txs: types.Transactions{pricedTransaction(0, 100000, big.NewInt(3), other)}, BlockNumber: 1}); err != nil {
+		t.Fatalf("failed to add bundle: %v", err)
+	}
+	if _, ok := pool.bundles.bundles[cheapest.Hash()]; ok {
+		t.Errorf("cheapest bundle not evicted")
+	}
+	if count := len(pool.bundles.bundles); count != maxBundles {
+		t.Errorf("bundles count mismatch: have %d, want %d", count, maxBundles)
+	}
+}
+
+// Tests that the pending and queued transactions of the remote accounts are
+// stored in the pool snapshot within its limits, and revalidated when loaded.
+func TestTransactionSnapshot(t *testing.T) {
+	t.Parallel()
+
+	snapshot := filepath.Join(t.TempDir(), "txpool.rlp")
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	blockchain := &testBlockChain{1000000, statedb, new(event.Feed)}
+
+	config := testTxPoolConfig
+	config.Snapshot = snapshot
+	config.SnapshotAccountSlots = 3
+	config.SnapshotGlobalSlots = 10
+
+	pool := NewTxPool(config, params.TestChainConfig, blockchain)
+
+	keyA, _ := crypto.GenerateKey()
+	keyB, _ := crypto.GenerateKey()
+	keyC, _ := crypto.GenerateKey()
+	addrA, addrB := crypto.PubkeyToAddress(keyA.PublicKey), crypto.PubkeyToAddress(keyB.PublicKey)
+
+	testAddBalance(pool, addrA, big.NewInt(1000000000))
+	testAddBalance(pool, addrB, big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(keyC.PublicKey), big.NewInt(1000000000))
+
+	// Four pending and a queued transactions for A, one pending for B
+	txs := []*types.Transaction{
+		pricedTransaction(0, 100000, big.NewInt(1), keyA),
+		pricedTransaction(1, 100000, big.NewInt(1), keyA),
+		pricedTransaction(2, 100000, big.NewInt(1), keyA),
+		pricedTransaction(3, 100000, big.NewInt(1), keyA),
+		pricedTransaction(5, 100000, big.NewInt(1), keyA),
+		pricedTransaction(0, 100000, big.NewInt(2), keyB),
+	}
+	for i, err := range pool.AddRemotesSync(txs) {
+		if err != nil {
+			t.Fatalf("failed to add transaction %d: %v", i, err)
+		}
+	}
+	// A local transaction for C, left to the journal
+	local := pricedTransaction(0, 100000, big.NewInt(3), keyC)
+	if err := pool.AddLocal(local); err != nil {
+		t.Fatalf("failed to add local transaction: %v", err)
+	}
+	if pending, queued := pool.Stats(); pending != 6 || queued != 1 {
+		t.Fatalf("transactions mismatched: have %d pending and %d queued, want 6 and 1", pending, queued)
+	}
+	pool.Stop()
+
+	// Restart with the first nonce of A used, only two of its three stored
+	// transactions are still valid
+	statedb.SetNonce(addrA, 1)
+	blockchain = &testBlockChain{1000000, statedb, new(event.Feed)}
+
+	pool = NewTxPool(config, params.TestChainConfig, blockchain)
+	defer pool.Stop()
+
+	if pending, queued := pool.Stats(); pending != 3 || queued != 0 {
+		t.Fatalf("transactions mismatched: have %d pending and %d queued, want 3 and 0", pending, queued)
+	}
+	for _, tx := range []*types.Transaction{txs[1], txs[2], txs[5]} {
+		if pool.Get(tx.Hash()) == nil {
+			t.Errorf("transaction %x not restored", tx.Hash())
+		}
+	}
+	if pool.Get(local.Hash()) != nil {
+		t.Errorf("local transaction restored from the snapshot")
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that the transactions dropped from the pool are recorded with their
+// reason, and streamed to the subscribers.
+func TestTransactionDropReasons(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	drops := make(chan TxDropEvent, 16)
+	sub := pool.SubscribeDropTxsEvent(drops)
+	defer sub.Unsubscribe()
+
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, addr, big.NewInt(1000000000))
+
+	// Replace a pending transaction
+	old, replacement := pricedTransaction(0, 100000, big.NewInt(1), key), pricedTransaction(0, 100000, big.NewInt(2), key)
+	if err := pool.addRemoteSync(old); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(replacement); err != nil {
+		t.Fatalf("failed to replace transaction: %v", err)
+	}
+	drop := pool.DropReason(old.Hash())
+	if drop == nil || drop.Reason != TxDropReplaced || drop.ReplacedBy == nil || *drop.ReplacedBy != replacement.Hash() {
+		t.Fatalf("replaced transaction drop mismatch: %+v", drop)
+	}
+	select {
+	case ev := <-drops:
+		if ev.Hash != old.Hash() || ev.Reason != TxDropReplaced {
+			t.Errorf("drop event mismatch: %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("drop event not fired")
+	}
+
+	// Use the nonce of a queued transaction
+	queued := pricedTransaction(5, 100000, big.NewInt(1), key)
+	if err := pool.addRemoteSync(queued); err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	pool.mu.Lock()
+	pool.currentState.SetNonce(addr, 6)
+	pool.mu.Unlock()
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer, addr))
+
+	if drop := pool.DropReason(queued.Hash()); drop == nil || drop.Reason != TxDropNonceTooLow {
+		t.Errorf("stale transaction drop mismatch: %+v", drop)
+	}
+	if drop := pool.DropReason(replacement.Hash()); drop != nil {
+		t.Errorf("unexpected drop of a pooled transaction: %+v", drop)
+	}
+}
+
+// Tests that the transactions included by any of the blocks imported at once
+// aren't recorded as dropped, unlike the ones whose nonce was used by another
+// transaction.
+func TestTransactionDropReasonsMultiBlockReset(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key, _  = crypto.GenerateKey()
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		db      = rawdb.NewMemoryDatabase()
+		price   = big.NewInt(10 * params.GWei)
+		genesis = (&Genesis{
+			Config:  params.TestChainConfig,
+			Alloc:   GenesisAlloc{addr: {Balance: big.NewInt(params.Ether)}},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}).MustCommit(db)
+	)
+	chain, err := NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	pool := NewTxPool(testTxPoolConfig, params.TestChainConfig, chain)
+	defer pool.Stop()
+
+	txs := []*types.Transaction{
+		pricedTransaction(0, 100000, price, key),
+		pricedTransaction(1, 100000, price, key),
+		pricedTransaction(2, 100000, price, key),
+	}
+	for i, err := range pool.AddRemotesSync(txs) {
+		if err != nil {
+			t.Fatalf("failed to add transaction %d: %v", i, err)
+		}
+	}
+
+	// The first block includes the first transaction, the second one replaces
+	// the second transaction, and the third block is empty
+	replacement := pricedTransaction(1, 100000, new(big.Int).Add(price, common.Big1), key)
+	blocks, _ := GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), db, 3, func(i int, b *BlockGen) {
+		switch i {
+		case 0:
+			b.AddTx(txs[0])
+		case 1:
+			b.AddTx(replacement)
+		}
+	})
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert block %d: %v", n, err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for pool.DropReason(txs[1].Hash()) == nil {
+		if time.Now().After(deadline) {
+			t.Fatalf("replaced transaction drop not recorded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if drop := pool.DropReason(txs[1].Hash()); drop.Reason != TxDropNonceTooLow {
+		t.Errorf("replaced transaction drop mismatch: %+v", drop)
+	}
+	if drop := pool.DropReason(txs[0].Hash()); drop != nil {
+		t.Errorf("mined transaction recorded as dropped: %+v", drop)
+	}
+	if pool.Get(txs[2].Hash()) == nil {
+		t.Errorf("executable transaction not kept")
+	}
+}
+
+// Tests that the drops buffer only keeps the most recent drops.
+func TestTransactionDropsLimit(t *testing.T) {
+	t.Parallel()
+
+	drops := newTxDrops(2)
+
+	hashes := []common.Hash{{0x01}, {0x02}, {0x03}}
+	for _, hash := range hashes {
+		drops.add(hash, TxDropUnderpriced, nil)
+	}
+	if _, ok := drops.get(hashes[0]); ok {
+		t.Errorf("oldest drop not overwritten")
+	}
+	for _, hash := range hashes[1:] {
+		if ev, ok := drops.get(hash); !ok || ev.Hash != hash || ev.Reason != TxDropUnderpriced {
+			t.Errorf("drop %x mismatch: %+v", hash, ev)
+		}
+	}
+	if unsent := drops.flush(); len(unsent) != 2 || unsent[0].Hash != hashes[1] || unsent[1].Hash != hashes[2] {
+		t.Errorf("unsent drops mismatch: %v", unsent)
+	}
+	if unsent := drops.flush(); len(unsent) != 0 {
+		t.Errorf("drops sent twice: %v", unsent)
+	}
+}
+
+// Tests that private transactions are validated as remote ones, and that the
+// private section is limited per account and evicts the cheapest transactions
+// once full.
+func TestPrivateTransactionLimits(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	pool.SetGasPrice(big.NewInt(2))
+	testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+
+	if err := pool.AddPrivate(pricedTransaction(0, 100000, big.NewInt(1), key), 10, false); !errors.Is(err, ErrUnderpriced) {
+		t.Errorf("expected %v, got %v", ErrUnderpriced, err)
+	}
+
+	for i := 0; i < maxPrivateAccountTxs; i++ {
+		if err := pool.AddPrivate(pricedTransaction(uint64(i), 100000, big.NewInt(3), key), 10, false); err != nil {
+			t.Fatalf("failed to add private transaction %d: %v", i, err)
+		}
+	}
+	if err := pool.AddPrivate(pricedTransaction(maxPrivateAccountTxs, 100000, big.NewInt(3), key), 10, false); !errors.Is(err, ErrPrivateAccountLimit) {
+		t.Errorf("expected %v, got %v", ErrPrivateAccountLimit, err)
+	}
+
+	// Fill the private section, the first transaction paying less than the others
+	var cheapest *types.Transaction
+
+	for len(pool.PendingPrivate(0)) < maxPrivateTxs/maxPrivateAccountTxs {
+		key, _ := crypto.GenerateKey()
+		testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+
+		for i := 0; i < maxPrivateAccountTxs; i++ {
+			price := big.NewInt(3)
+			if cheapest == nil {
+				price = big.NewInt(2)
+			}
+			tx := pricedTransaction(uint64(i), 100000, price, key)
+			if err := pool.AddPrivate(tx, 10, false); err != nil {
+				t.Fatalf("failed to add private transaction: %v", err)
+			}
+			if cheapest == nil {
+				cheapest = tx
+			}
+		}
+	}
+
+	other, _ := crypto.GenerateKey()
+	testAddBalance(pool, crypto.PubkeyToAddress(other.PublicKey), big.NewInt(1000000000))
+
+	if err := pool.AddPrivate(pricedTransaction(0, 100000, big.NewInt(2), other), 10, false); !errors.Is(err, ErrUnderpriced) {
+		t.Errorf("expected %v, got %v", ErrUnderpriced, err)
+	}
+	if err := pool.AddPrivate(pricedTransaction(0, 100000, big.NewInt(3), other), 10, false); err != nil {
+		t.Fatalf("failed to add private transaction: %v", err)
+	}
+	if pool.private.has(cheapest.Hash()) {
+		t.Errorf("cheapest private transaction not evicted")
+	}
+	if count := len(pool.private.txs); count != maxPrivateTxs {
+		t.Errorf("private transactions count mismatch: have %d, want %d", count, maxPrivateTxs)
+	}
+}
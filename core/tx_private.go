@@ -0,0 +1,160 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// maxPrivateTxs is the maximum number of private transactions kept by the pool
+	maxPrivateTxs = 1024
+
+	// maxPrivateAccountTxs is the maximum number of private transactions kept by
+	// the pool for a single account
+	maxPrivateAccountTxs = 16
+)
+
+// ErrPrivateAccountLimit is returned if an account already has the maximum
+// number of private transactions in the pool.
+var ErrPrivateAccountLimit = errors.New("too many private transactions from account")
+
+var (
+	privateGauge          = metrics.NewRegisteredGauge("txpool/private", nil)
+	privateEvictedMeter   = metrics.NewRegisteredMeter("txpool/private/evicted", nil)
+	privateExpiredMeter   = metrics.NewRegisteredMeter("txpool/private/expired", nil)
+	privatePublishedMeter = metrics.NewRegisteredMeter("txpool/private/published", nil)
+)
+
+// privateTx is a transaction submitted to be included by the local block
+// producer only, up to a block number.
+type privateTx struct {
+	tx       *types.Transaction
+	from     common.Address
+	maxBlock uint64 // Last block the transaction can be included in
+	publish  bool   // Whether to add the transaction to the public pool once expired
+}
+
+// privateTxs is the section of the pool holding the private transactions. They
+// are never announced nor broadcast to peers.
+type privateTxs struct {
+	lock sync.RWMutex
+	txs  map[common.Hash]*privateTx
+}
+
+func newPrivateTxs() *privateTxs {
+	return &privateTxs{
+		txs: make(map[common.Hash]*privateTx),
+	}
+}
+
+// add inserts a private transaction, failing if its account has too many of
+// them already. If the section is full, the cheapest transaction according to
+// cmp is evicted, unless the new one doesn't pay more.
+func (p *privateTxs) add(ptx *privateTx, cmp func(a, b *types.Transaction) int) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.txs[ptx.tx.Hash()]; ok {
+		return ErrAlreadyKnown
+	}
+
+	var (
+		accountTxs int
+		cheapest   *privateTx
+	)
+
+	for _, other := range p.txs {
+		if other.from == ptx.from {
+			accountTxs++
+		}
+
+		if cheapest == nil || cmp(other.tx, cheapest.tx) < 0 {
+			cheapest = other
+		}
+	}
+
+	if accountTxs >= maxPrivateAccountTxs {
+		return ErrPrivateAccountLimit
+	}
+
+	if len(p.txs) >= maxPrivateTxs {
+		if cmp(ptx.tx, cheapest.tx) <= 0 {
+			return ErrUnderpriced
+		}
+
+		delete(p.txs, cheapest.tx.Hash())
+		privateEvictedMeter.Mark(1)
+	}
+
+	p.txs[ptx.tx.Hash()] = ptx
+	privateGauge.Update(int64(len(p.txs)))
+
+	return nil
+}
+
+// has returns whether the transaction is in the private section.
+func (p *privateTxs) has(hash common.Hash) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	_, ok := p.txs[hash]
+
+	return ok
+}
+
+// pending returns the private transactions which can be included in the block
+// with the given number, grouped by account and sorted by nonce.
+func (p *privateTxs) pending(number uint64) map[common.Address]types.Transactions {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	pending := make(map[common.Address]types.Transactions)
+
+	for _, ptx := range p.txs {
+		if ptx.maxBlock >= number {
+			pending[ptx.from] = append(pending[ptx.from], ptx.tx)
+		}
+	}
+
+	for _, txs := range pending {
+		sort.Sort(types.TxByNonce(txs))
+	}
+
+	return pending
+}
+
+// reset drops the private transactions which can't be included after the
+// block with the given number anymore, either because they expired or because
+// their nonce was used. The expired transactions to publish are returned.
+func (p *privateTxs) reset(number uint64, statedb *state.StateDB) types.Transactions {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var publish types.Transactions
+
+	for hash, ptx := range p.txs {
+		switch {
+		case ptx.tx.Nonce() < statedb.GetNonce(ptx.from):
+			delete(p.txs, hash)
+
+		case ptx.maxBlock <= number:
+			delete(p.txs, hash)
+			privateExpiredMeter.Mark(1)
+
+			if ptx.publish {
+				publish = append(publish, ptx.tx)
+			}
+		}
+	}
+
+	privateGauge.Update(int64(len(p.txs)))
+	privatePublishedMeter.Mark(int64(len(publish)))
+
+	return publish
+}
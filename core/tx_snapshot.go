@@ -0,0 +1,147 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// txSnapshot is a dump of the pending and queued transactions of the remote
+// accounts, to refill the pool after a node restart. The local ones are left to
+// the journal. Unlike the journal, it's not updated when transactions are added,
+// only regenerated from time to time.
+type txSnapshot struct {
+	path         string // Filesystem path to store the transactions at
+	accountSlots uint64 // Maximum number of transactions stored per account
+	globalSlots  uint64 // Maximum number of transactions stored for all accounts
+}
+
+// newTxSnapshot creates a new transaction pool snapshot.
+func newTxSnapshot(path string, accountSlots uint64, globalSlots uint64) *txSnapshot {
+	return &txSnapshot{
+		path:         path,
+		accountSlots: accountSlots,
+		globalSlots:  globalSlots,
+	}
+}
+
+// load parses a transaction pool snapshot from disk, adding its contents to
+// the pool, which validates them against the current state.
+func (snapshot *txSnapshot) load(add func([]*types.Transaction) []error) error {
+	input, err := os.Open(snapshot.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	defer input.Close()
+
+	var (
+		stream  = rlp.NewStream(input, 0)
+		total   int
+		dropped int
+		batch   types.Transactions
+		failure error
+	)
+
+	loadBatch := func(txs types.Transactions) {
+		for _, err := range add(txs) {
+			if err != nil {
+				log.Debug("Failed to add snapshot transaction", "err", err)
+
+				dropped++
+			}
+		}
+	}
+
+	for {
+		tx := new(types.Transaction)
+		if err = stream.Decode(tx); err != nil {
+			if err != io.EOF {
+				failure = err
+			}
+
+			break
+		}
+
+		total++
+
+		if batch = append(batch, tx); batch.Len() > 1024 {
+			loadBatch(batch)
+			batch = batch[:0]
+		}
+	}
+
+	if batch.Len() > 0 {
+		loadBatch(batch)
+	}
+
+	log.Info("Loaded transaction pool snapshot", "transactions", total, "dropped", dropped)
+
+	return failure
+}
+
+// save regenerates the snapshot from the given pending and queued transactions.
+// The pending transactions are stored first, and the accounts paying the highest
+// tips first, so those are kept when the limits are reached.
+func (snapshot *txSnapshot) save(pending map[common.Address]types.Transactions, queued map[common.Address]types.Transactions) error {
+	output, err := os.OpenFile(snapshot.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	var (
+		stored   uint64
+		accounts = make(map[common.Address]uint64)
+	)
+
+	for _, txsByAccount := range []map[common.Address]types.Transactions{pending, queued} {
+		addrs := make([]common.Address, 0, len(txsByAccount))
+		for addr, txs := range txsByAccount {
+			if len(txs) > 0 {
+				addrs = append(addrs, addr)
+			}
+		}
+
+		sort.Slice(addrs, func(i, j int) bool {
+			return txsByAccount[addrs[i]][0].GasTipCapCmp(txsByAccount[addrs[j]][0]) > 0
+		})
+
+		for _, addr := range addrs {
+			for _, tx := range txsByAccount[addr] {
+				if stored >= snapshot.globalSlots || accounts[addr] >= snapshot.accountSlots {
+					break
+				}
+
+				if err = rlp.Encode(output, tx); err != nil {
+					output.Close()
+					return err
+				}
+
+				stored++
+				accounts[addr]++
+			}
+		}
+	}
+
+	if err = output.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Rename(snapshot.path+".new", snapshot.path); err != nil {
+		return err
+	}
+
+	log.Info("Regenerated transaction pool snapshot", "transactions", stored, "accounts", len(accounts))
+
+	return nil
+}
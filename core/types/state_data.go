@@ -8,4 +8,5 @@ type StateSyncData struct {
 	Contract common.Address
 	Data     string
 	TxHash   common.Hash
+	Time     uint64 // Unix time at which heimdall recorded the event
 }
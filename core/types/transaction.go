@@ -694,10 +694,49 @@ func (t *TransactionsByPriceAndNonce) Shift() {
 	heap.Pop(&t.heads)
 }
 
+// ShiftSender replaces the head of the given account with the next transaction
+// from the same account, wherever the head is in the price order.
+func (t *TransactionsByPriceAndNonce) ShiftSender(acc common.Address) {
+	for i, head := range t.heads {
+		if from, _ := Sender(t.signer, head.tx); from != acc {
+			continue
+		}
+
+		if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
+			if wrapped, err := NewTxWithMinerFee(txs[0], t.baseFee); err == nil {
+				t.heads[i], t.txs[acc] = wrapped, txs[1:]
+				heap.Fix(&t.heads, i)
+
+				return
+			}
+		}
+
+		heap.Remove(&t.heads, i)
+
+		return
+	}
+}
+
 func (t *TransactionsByPriceAndNonce) GetTxs() int {
 	return len(t.txs)
 }
 
+// Copy returns a copy of the transaction set, which can be iterated over without
+// affecting the original one.
+func (t *TransactionsByPriceAndNonce) Copy() *TransactionsByPriceAndNonce {
+	txs := make(map[common.Address]Transactions, len(t.txs))
+	for acc, accTxs := range t.txs {
+		txs[acc] = accTxs
+	}
+
+	return &TransactionsByPriceAndNonce{
+		txs:     txs,
+		heads:   append(TxByPriceAndTime{}, t.heads...),
+		signer:  t.signer,
+		baseFee: t.baseFee,
+	}
+}
+
 // Pop removes the best transaction, *not* replacing it with the next one from
 // the same account. This should be used when a transaction cannot be executed
 // and hence all subsequent ones should be discarded from the same account.
@@ -429,6 +429,86 @@ func TestTransactionTimeSort(t *testing.T) {
 	}
 }
 
+// Tests that iterating over a copy of a transaction set doesn't affect the
+// original set, and yields the same ordering.
+func TestTransactionsByPriceAndNonceCopy(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 5)
+	for i := 0; i < len(keys); i++ {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	signer := HomesteadSigner{}
+
+	groups := map[common.Address]Transactions{}
+	for start, key := range keys {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		for i := 0; i < 3; i++ {
+			tx, _ := SignTx(NewTransaction(uint64(i), common.Address{}, big.NewInt(100), 100, big.NewInt(int64(start+i)), nil), signer, key)
+			groups[addr] = append(groups[addr], tx)
+		}
+	}
+	txset := NewTransactionsByPriceAndNonce(signer, groups, nil)
+
+	cpy := txset.Copy()
+	copied := Transactions{}
+	for tx := cpy.Peek(); tx != nil; tx = cpy.Peek() {
+		copied = append(copied, tx)
+		cpy.Shift()
+	}
+	if len(copied) != 3*len(keys) {
+		t.Fatalf("expected %d transactions in the copy, found %d", 3*len(keys), len(copied))
+	}
+	for i, tx := range copied {
+		if head := txset.Peek(); head != tx {
+			t.Fatalf("transaction %d mismatch: have %x, want %x", i, head.Hash(), tx.Hash())
+		}
+		txset.Shift()
+	}
+	if txset.Peek() != nil {
+		t.Errorf("expected the original set to be exhausted")
+	}
+}
+
+func TestTransactionsByPriceAndNonceShiftSender(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 3)
+	for i := 0; i < len(keys); i++ {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	signer := HomesteadSigner{}
+
+	groups := map[common.Address]Transactions{}
+	for start, key := range keys {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		for i := 0; i < 2; i++ {
+			tx, _ := SignTx(NewTransaction(uint64(i), common.Address{}, big.NewInt(100), 100, big.NewInt(int64(10*(start+1)-i)), nil), signer, key)
+			groups[addr] = append(groups[addr], tx)
+		}
+	}
+	txset := NewTransactionsByPriceAndNonce(signer, groups, nil)
+
+	// shift the cheapest account, without touching the best head
+	cheapest := crypto.PubkeyToAddress(keys[0].PublicKey)
+	best := txset.Peek()
+
+	txset.ShiftSender(cheapest)
+	txset.ShiftSender(cheapest)
+	txset.ShiftSender(cheapest)
+
+	if txset.Peek() != best {
+		t.Fatalf("best head changed: have %x, want %x", txset.Peek().Hash(), best.Hash())
+	}
+	count := 0
+	for tx := txset.Peek(); tx != nil; tx = txset.Peek() {
+		if from, _ := Sender(signer, tx); from == cheapest {
+			t.Fatalf("transaction %x of the shifted account still in the set", tx.Hash())
+		}
+		count++
+		txset.Shift()
+	}
+	if count != 4 {
+		t.Errorf("expected 4 remaining transactions, found %d", count)
+	}
+}
+
 // TestTransactionCoding tests serializing/de-serializing to/from rlp and JSON.
 func TestTransactionCoding(t *testing.T) {
 	key, err := crypto.GenerateKey()
@@ -60,6 +60,7 @@ type Config struct {
 	// parallel EVM configs
 	ParallelEnable               bool
 	ParallelSpeculativeProcesses int
+	ParallelVerifyDependencies   bool
 }
 
 // ScopeContext contains the things that are per-call, such as stack and memory,
@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/blockstm"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -122,11 +123,7 @@ func (api *PrivateMinerAPI) SetExtra(extra string) (bool, error) {
 
 // SetGasPrice sets the minimum accepted gas price for the miner.
 func (api *PrivateMinerAPI) SetGasPrice(gasPrice hexutil.Big) bool {
-	api.e.lock.Lock()
-	api.e.gasPrice = (*big.Int)(&gasPrice)
-	api.e.lock.Unlock()
-
-	api.e.txPool.SetGasPrice((*big.Int)(&gasPrice))
+	api.e.SetGasPrice((*big.Int)(&gasPrice))
 	return true
 }
 
@@ -608,3 +605,51 @@ func (api *PrivateDebugAPI) GetAccessibleState(from, to rpc.BlockNumber) (uint64
 	}
 	return 0, fmt.Errorf("No state found")
 }
+
+// blockstmProfileReexec is the number of blocks re-executed at most to
+// regenerate the pre-state of a profiled block
+const blockstmProfileReexec = uint64(128)
+
+// BlockstmProfile re-executes a block with the parallel state processor and
+// returns its dependency graph and execution statistics.
+func (api *PrivateDebugAPI) BlockstmProfile(ctx context.Context, number rpc.BlockNumber) (*blockstm.Profile, error) {
+	block, err := api.eth.APIBackend.BlockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block #%d not found", number)
+	}
+	if block.NumberU64() == 0 {
+		return nil, errors.New("genesis is not executable")
+	}
+	chain := api.eth.BlockChain()
+	parent := chain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return nil, fmt.Errorf("parent %#x not found", block.ParentHash())
+	}
+	statedb, err := api.eth.StateAtBlock(parent, blockstmProfileReexec, nil, true, false)
+	if err != nil {
+		return nil, err
+	}
+	processor := core.NewParallelStateProcessor(chain.Config(), chain, chain.Engine())
+	receipts, result, err := processor.Profile(block, statedb, *chain.GetVMConfig(), ctx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		txs     = block.Transactions()
+		hashes  = make([]common.Hash, len(txs))
+		gasUsed = make([]uint64, len(txs))
+	)
+	for i, tx := range txs {
+		hashes[i] = tx.Hash()
+		if i < len(receipts) {
+			gasUsed[i] = receipts[i].GasUsed
+		}
+	}
+	profile := blockstm.NewProfile(result, hashes, gasUsed)
+	profile.BlockNumber = block.NumberU64()
+	profile.BlockHash = block.Hash()
+	return profile, nil
+}
@@ -20,11 +20,15 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/tracing"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
@@ -72,6 +76,13 @@ func (b *EthAPIBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumb
 	if number == rpc.LatestBlockNumber {
 		return b.eth.blockchain.CurrentBlock().Header(), nil
 	}
+	if number == rpc.FinalizedBlockNumber {
+		finalized, err := b.finalizedBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		return b.eth.blockchain.GetHeaderByNumber(finalized), nil
+	}
 	return b.eth.blockchain.GetHeaderByNumber(uint64(number)), nil
 }
 
@@ -106,9 +117,26 @@ func (b *EthAPIBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumbe
 	if number == rpc.LatestBlockNumber {
 		return b.eth.blockchain.CurrentBlock(), nil
 	}
+	if number == rpc.FinalizedBlockNumber {
+		finalized, err := b.finalizedBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		return b.eth.blockchain.GetBlockByNumber(finalized), nil
+	}
 	return b.eth.blockchain.GetBlockByNumber(uint64(number)), nil
 }
 
+// finalizedBlockNumber returns the end block of the latest whitelisted milestone,
+// which can't be reorged anymore.
+func (b *EthAPIBackend) finalizedBlockNumber() (uint64, error) {
+	exists, number, _ := b.eth.Downloader().ChainValidator.GetWhitelistedMilestone()
+	if !exists {
+		return 0, errors.New("finalized block not found")
+	}
+	return number, nil
+}
+
 func (b *EthAPIBackend) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
 	return b.eth.blockchain.GetBlockByHash(hash), nil
 }
@@ -152,7 +180,7 @@ func (b *EthAPIBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.B
 	if header == nil {
 		return nil, nil, errors.New("header not found")
 	}
-	stateDb, err := b.eth.BlockChain().StateAt(header.Root)
+	stateDb, err := b.stateAt(ctx, header)
 	return stateDb, header, err
 }
 
@@ -171,12 +199,30 @@ func (b *EthAPIBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockN
 		if blockNrOrHash.RequireCanonical && b.eth.blockchain.GetCanonicalHash(header.Number.Uint64()) != hash {
 			return nil, nil, errors.New("hash is not currently canonical")
 		}
-		stateDb, err := b.eth.BlockChain().StateAt(header.Root)
+		stateDb, err := b.stateAt(ctx, header)
 		return stateDb, header, err
 	}
 	return nil, nil, errors.New("invalid arguments; neither block nor hash specified")
 }
 
+// stateAt returns the state of the block, tracing its opening as part of the
+// request of the context.
+func (b *EthAPIBackend) stateAt(ctx context.Context, header *types.Header) (*state.StateDB, error) {
+	_, span := tracing.StartSpan(ctx, "backend.StateAt")
+	defer tracing.EndSpan(span)
+
+	tracing.SetAttributes(
+		span,
+		attribute.Int64("number", header.Number.Int64()),
+		attribute.String("root", header.Root.String()),
+	)
+
+	stateDb, err := b.eth.BlockChain().StateAt(header.Root)
+	tracing.RecordError(span, err)
+
+	return stateDb, err
+}
+
 func (b *EthAPIBackend) GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
 	return b.eth.blockchain.GetReceiptsByHash(hash), nil
 }
@@ -235,6 +281,14 @@ func (b *EthAPIBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscri
 	return b.eth.BlockChain().SubscribeLogsEvent(ch)
 }
 
+func (b *EthAPIBackend) SendPrivateTx(ctx context.Context, signedTx *types.Transaction, maxBlock uint64, publish bool) error {
+	return b.eth.txPool.AddPrivate(signedTx, maxBlock, publish)
+}
+
+func (b *EthAPIBackend) SendBundle(ctx context.Context, bundle *core.Bundle) error {
+	return b.eth.txPool.AddBundle(bundle)
+}
+
 func (b *EthAPIBackend) SendTx(ctx context.Context, signedTx *types.Transaction) error {
 	err := b.eth.txPool.AddLocal(signedTx)
 	if err != nil {
@@ -288,6 +342,14 @@ func (b *EthAPIBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.S
 	return b.eth.TxPool().SubscribeNewTxsEvent(ch)
 }
 
+func (b *EthAPIBackend) TxPoolDropReason(txHash common.Hash) *core.TxDropEvent {
+	return b.eth.TxPool().DropReason(txHash)
+}
+
+func (b *EthAPIBackend) SubscribeDropTxsEvent(ch chan<- core.TxDropEvent) event.Subscription {
+	return b.eth.TxPool().SubscribeDropTxsEvent(ch)
+}
+
 func (b *EthAPIBackend) SyncProgress() ethereum.SyncProgress {
 	return b.eth.Downloader().Progress()
 }
@@ -321,7 +383,17 @@ func (b *EthAPIBackend) UnprotectedAllowed() bool {
 }
 
 func (b *EthAPIBackend) RPCGasCap() uint64 {
-	return b.eth.config.RPCGasCap
+	return atomic.LoadUint64(&b.eth.config.RPCGasCap)
+}
+
+// SetRPCGasCap sets the global gas cap for eth-call variants.
+func (b *EthAPIBackend) SetRPCGasCap(gasCap uint64) {
+	atomic.StoreUint64(&b.eth.config.RPCGasCap, gasCap)
+}
+
+// SetGasPriceOracle reconfigures the gas price oracle.
+func (b *EthAPIBackend) SetGasPriceOracle(params gasprice.Config) {
+	b.gpo.SetConfig(params)
 }
 
 func (b *EthAPIBackend) RPCRpcReturnDataLimit() uint64 {
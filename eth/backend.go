@@ -33,6 +33,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/beacon"
 	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
@@ -52,6 +53,7 @@ import (
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/internal/shutdowncheck"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/miner"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p"
@@ -211,6 +213,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			EnablePreimageRecording:      config.EnablePreimageRecording,
 			ParallelEnable:               config.ParallelEVM.Enable,
 			ParallelSpeculativeProcesses: config.ParallelEVM.SpeculativeProcesses,
+			ParallelVerifyDependencies:   config.ParallelEVM.VerifyDependencies,
 		}
 		cacheConfig = &core.CacheConfig{
 			TrieCleanLimit:      config.TrieCleanCache,
@@ -226,7 +229,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		}
 	)
 
-	checker := whitelist.NewService(10)
+	checker := whitelist.NewService(chainDb, 10)
 
 	// check if Parallel EVM is enabled
 	// if enabled, use parallel state processor
@@ -256,6 +259,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
+	if config.TxPool.Snapshot != "" {
+		config.TxPool.Snapshot = stack.ResolvePath(config.TxPool.Snapshot)
+	}
 	eth.txPool = core.NewTxPool(config.TxPool, chainConfig, eth.blockchain)
 
 	// Permit the downloader to use the trie cache allowance during fast sync
@@ -485,6 +491,16 @@ func (s *Ethereum) SetEtherbase(etherbase common.Address) {
 	s.miner.SetEtherbase(etherbase)
 }
 
+// SetGasPrice sets the minimum gas price for mining a transaction, which is
+// also enforced by the transaction pool.
+func (s *Ethereum) SetGasPrice(gasPrice *big.Int) {
+	s.lock.Lock()
+	s.gasPrice = gasPrice
+	s.lock.Unlock()
+
+	s.txPool.SetGasPrice(gasPrice)
+}
+
 // StartMining starts the miner with the given number of CPU threads. If mining
 // is already running, this method adjust the number of threads allowed to use
 // and updates the minimum price required by the transaction pool.
@@ -640,6 +656,8 @@ func (s *Ethereum) Start() error {
 	s.handler.Start(maxPeers)
 
 	go s.startCheckpointWhitelistService()
+	go s.startMilestoneWhitelistService()
+	go s.startSlotMetricsService()
 
 	return nil
 }
@@ -649,8 +667,15 @@ var (
 	ErrBorConsensusWithoutHeimdall = errors.New("bor consensus without heimdall")
 
 	whitelistTimeout = 30 * time.Second
+
+	// milestoneInterval is how often the latest milestone is fetched, milestones
+	// being voted on heimdall every few blocks.
+	milestoneInterval = 12 * time.Second
 )
 
+// chainEventChanSize is the size of channel listening to ChainEvent.
+const chainEventChanSize = 10
+
 // StartCheckpointWhitelistService starts the goroutine to fetch checkpoints and update the
 // checkpoint whitelist map.
 func (s *Ethereum) startCheckpointWhitelistService() {
@@ -726,6 +751,83 @@ func (s *Ethereum) handleWhitelistCheckpoint(ctx context.Context, first bool) er
 	return nil
 }
 
+// startMilestoneWhitelistService starts the goroutine to fetch the latest milestone
+// and update the milestone whitelist.
+func (s *Ethereum) startMilestoneWhitelistService() {
+	ticker := time.NewTicker(milestoneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), whitelistTimeout)
+			err := s.handleWhitelistMilestone(ctx)
+
+			cancel()
+
+			if errors.Is(err, ErrBorConsensusWithoutHeimdall) || errors.Is(err, ErrNotBorConsensus) || errors.Is(err, milestone.ErrNotSupported) {
+				return
+			}
+
+			if err != nil {
+				log.Debug("unable to whitelist milestone", "err", err)
+			}
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
+// handleWhitelistMilestone handles the milestone whitelist mechanism.
+func (s *Ethereum) handleWhitelistMilestone(ctx context.Context) error {
+	ethHandler := (*ethHandler)(s.handler)
+
+	bor, ok := ethHandler.chain.Engine().(*bor.Bor)
+	if !ok {
+		return ErrNotBorConsensus
+	}
+
+	if bor.HeimdallClient == nil {
+		return ErrBorConsensusWithoutHeimdall
+	}
+
+	num, hash, err := ethHandler.fetchWhitelistMilestone(ctx, bor)
+	if err != nil {
+		return err
+	}
+
+	ethHandler.downloader.ProcessMilestone(num, hash)
+
+	return nil
+}
+
+// startSlotMetricsService starts the goroutine updating the block production
+// metrics with each block becoming canonical, be it imported or mined.
+func (s *Ethereum) startSlotMetricsService() {
+	bor, ok := s.engine.(*bor.Bor)
+	if !ok || !metrics.Enabled {
+		return
+	}
+
+	chainCh := make(chan core.ChainEvent, chainEventChanSize)
+	chainSub := s.blockchain.SubscribeChainEvent(chainCh)
+
+	defer chainSub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-chainCh:
+			if err := bor.RecordSlot(s.blockchain, ev.Block.Header()); err != nil {
+				log.Debug("unable to record block slot", "number", ev.Block.NumberU64(), "err", err)
+			}
+		case <-chainSub.Err():
+			return
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
 // Stop implements node.Lifecycle, terminating all internal goroutines used by the
 // Ethereum protocol.
 func (s *Ethereum) Stop() error {
@@ -6,37 +6,76 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
 )
 
-// Checkpoint whitelist
+// Checkpoint and milestone whitelist
 type Service struct {
 	m                   sync.Mutex
 	checkpointWhitelist map[uint64]common.Hash // Checkpoint whitelist, populated by reaching out to heimdall
 	checkpointOrder     []uint64               // Checkpoint order, populated by reaching out to heimdall
 	maxCapacity         uint                   // Max capacity of the whitelist
 	checkpointInterval  uint64                 // Checkpoint interval, until which we can allow importing
+	db                  ethdb.Database         // Database the checkpoint whitelist is persisted to and canonical blocks are read from, if any
+
+	milestoneExists bool        // Whether a milestone has been whitelisted
+	milestoneNumber uint64      // End block number of the latest milestone, populated by reaching out to heimdall
+	milestoneHash   common.Hash // End block hash of the latest milestone
 }
 
-func NewService(maxCapacity uint) *Service {
-	return &Service{
+// NewService creates a whitelist service, reloading the checkpoint whitelist
+// persisted in db. The db may be nil, in which case nothing is persisted.
+func NewService(db ethdb.Database, maxCapacity uint) *Service {
+	s := &Service{
 		checkpointWhitelist: make(map[uint64]common.Hash),
 		checkpointOrder:     []uint64{},
 		maxCapacity:         maxCapacity,
 		checkpointInterval:  256, // TODO: make it configurable through params?
+		db:                  db,
 	}
+
+	if db != nil {
+		entries := rawdb.ReadBorCheckpointWhitelist(db)
+		for _, entry := range entries {
+			s.enqueueCheckpointWhitelist(entry.Number, entry.Hash)
+		}
+
+		for s.length() > int(s.maxCapacity) {
+			s.dequeueCheckpointWhitelist()
+		}
+
+		if len(entries) > 0 {
+			log.Info("Loaded checkpoint whitelist", "entries", s.length(), "last", s.checkpointOrder[len(s.checkpointOrder)-1])
+		}
+	}
+
+	return s
 }
 
 var (
 	ErrCheckpointMismatch = errors.New("checkpoint mismatch")
 	ErrLongFutureChain    = errors.New("received future chain of unacceptable length")
 	ErrNoRemoteCheckpoint = errors.New("remote peer doesn't have a checkpoint")
+	ErrMilestoneMismatch  = errors.New("milestone mismatch")
+	ErrNoRemoteMilestone  = errors.New("remote peer doesn't have a milestone")
 )
 
 // IsValidPeer checks if the chain we're about to receive from a peer is valid or not
-// in terms of reorgs. We won't reorg beyond the last bor checkpoint submitted to mainchain.
+// in terms of reorgs. We won't reorg beyond the last bor checkpoint submitted to mainchain,
+// nor beyond the last milestone voted on heimdall.
 func (w *Service) IsValidPeer(remoteHeader *types.Header, fetchHeadersByNumber func(number uint64, amount int, skip int, reverse bool) ([]*types.Header, []common.Hash, error)) (bool, error) {
+	if isValid, err := w.isValidCheckpointPeer(fetchHeadersByNumber); !isValid || err != nil {
+		return isValid, err
+	}
+
+	return w.isValidMilestonePeer(fetchHeadersByNumber)
+}
+
+// isValidCheckpointPeer checks the peer's block at the last whitelisted checkpoint
+func (w *Service) isValidCheckpointPeer(fetchHeadersByNumber func(number uint64, amount int, skip int, reverse bool) ([]*types.Header, []common.Hash, error)) (bool, error) {
 	// We want to validate the chain by comparing the last checkpointed block
 	// we're storing in `checkpointWhitelist` with the peer's block.
 	//
@@ -73,9 +112,36 @@ func (w *Service) IsValidPeer(remoteHeader *types.Header, fetchHeadersByNumber f
 	return false, ErrCheckpointMismatch
 }
 
+// isValidMilestonePeer checks the peer's block at the whitelisted milestone
+func (w *Service) isValidMilestonePeer(fetchHeadersByNumber func(number uint64, amount int, skip int, reverse bool) ([]*types.Header, []common.Hash, error)) (bool, error) {
+	exists, number, hash := w.GetWhitelistedMilestone()
+	if !exists {
+		return true, nil
+	}
+
+	headers, hashes, err := fetchHeadersByNumber(number, 1, 0, false)
+	if err != nil {
+		return false, fmt.Errorf("%w: milestone %d, err %v", ErrNoRemoteMilestone, number, err)
+	}
+
+	if len(headers) == 0 {
+		return false, fmt.Errorf("%w: milestone %d", ErrNoRemoteMilestone, number)
+	}
+
+	if headers[0].Number.Uint64() == number && hashes[0] == hash {
+		return true, nil
+	}
+
+	return false, ErrMilestoneMismatch
+}
+
 // IsValidChain checks the validity of chain by comparing it
-// against the local checkpoint entries
+// against the local milestone and checkpoint entries
 func (w *Service) IsValidChain(currentHeader *types.Header, chain []*types.Header) (bool, error) {
+	if isValid, err := w.isValidMilestoneChain(currentHeader, chain); !isValid || err != nil {
+		return isValid, err
+	}
+
 	// Check if we have checkpoints to validate incoming chain in memory
 	if len(w.checkpointWhitelist) == 0 {
 		// We don't have any entries, no additional validation will be possible
@@ -126,6 +192,52 @@ func (w *Service) IsValidChain(currentHeader *types.Header, chain []*types.Heade
 	return true, nil
 }
 
+// isValidMilestoneChain rejects chains which conflict with the whitelisted milestone,
+// either by containing another block at its height or, once the milestone is part of
+// our canonical chain, by forking off it at or below its height.
+func (w *Service) isValidMilestoneChain(currentHeader *types.Header, chain []*types.Header) (bool, error) {
+	exists, number, hash := w.GetWhitelistedMilestone()
+	if !exists || len(chain) == 0 {
+		return true, nil
+	}
+
+	for _, header := range chain {
+		if header.Number.Uint64() != number {
+			continue
+		}
+
+		if header.Hash() != hash {
+			return false, fmt.Errorf("%w: block %d has hash %s, milestone has %s", ErrMilestoneMismatch, number, header.Hash(), hash)
+		}
+
+		return true, nil
+	}
+
+	// A chain starting after the milestone only forks off blocks above it
+	first := chain[0].Number.Uint64()
+	if first > number {
+		return true, nil
+	}
+
+	// Replacing our blocks below the milestone is fine until we have reached it
+	if w.db == nil || currentHeader.Number.Uint64() < number || rawdb.ReadCanonicalHash(w.db, number) != hash {
+		return true, nil
+	}
+
+	// Otherwise the chain must be ours, as re-imported blocks are, down to its parent
+	if first > 0 && rawdb.ReadCanonicalHash(w.db, first-1) != chain[0].ParentHash {
+		return false, fmt.Errorf("%w: chain forks off block %d, before milestone %d", ErrMilestoneMismatch, first-1, number)
+	}
+
+	for _, header := range chain {
+		if rawdb.ReadCanonicalHash(w.db, header.Number.Uint64()) != header.Hash() {
+			return false, fmt.Errorf("%w: chain forks off block %d, before milestone %d", ErrMilestoneMismatch, header.Number.Uint64()-1, number)
+		}
+	}
+
+	return true, nil
+}
+
 func splitChain(current uint64, chain []*types.Header) ([]*types.Header, []*types.Header) {
 	var (
 		pastChain   []*types.Header
@@ -157,21 +269,30 @@ func (w *Service) ProcessCheckpoint(endBlockNum uint64, endBlockHash common.Hash
 	w.m.Lock()
 	defer w.m.Unlock()
 
-	w.enqueueCheckpointWhitelist(endBlockNum, endBlockHash)
-	// If size of checkpoint whitelist map is greater than 10, remove the oldest entry.
+	if !w.enqueueCheckpointWhitelist(endBlockNum, endBlockHash) {
+		return
+	}
 
+	// If size of checkpoint whitelist map is greater than 10, remove the oldest entry.
 	if w.length() > int(w.maxCapacity) {
 		w.dequeueCheckpointWhitelist()
 	}
+
+	w.persistCheckpointWhitelist()
 }
 
-// GetCheckpointWhitelist returns the existing whitelisted
+// GetCheckpointWhitelist returns a copy of the existing whitelisted
 // entries of checkpoint of the form block number -> block hash.
 func (w *Service) GetCheckpointWhitelist() map[uint64]common.Hash {
 	w.m.Lock()
 	defer w.m.Unlock()
 
-	return w.checkpointWhitelist
+	whitelist := make(map[uint64]common.Hash, len(w.checkpointWhitelist))
+	for number, hash := range w.checkpointWhitelist {
+		whitelist[number] = hash
+	}
+
+	return whitelist
 }
 
 // PurgeCheckpointWhitelist purges data from checkpoint whitelist map
@@ -181,16 +302,75 @@ func (w *Service) PurgeCheckpointWhitelist() {
 
 	w.checkpointWhitelist = make(map[uint64]common.Hash)
 	w.checkpointOrder = make([]uint64, 0)
+
+	if w.db != nil {
+		rawdb.DeleteBorCheckpointWhitelist(w.db)
+	}
 }
 
-// EnqueueWhitelistBlock enqueues blockNumber, blockHash to the checkpoint whitelist map
-func (w *Service) enqueueCheckpointWhitelist(key uint64, val common.Hash) {
-	if _, ok := w.checkpointWhitelist[key]; !ok {
-		log.Debug("Enqueing new checkpoint whitelist", "block number", key, "block hash", val)
+// persistCheckpointWhitelist writes the checkpoint whitelist to the database, if any
+func (w *Service) persistCheckpointWhitelist() {
+	if w.db == nil {
+		return
+	}
 
-		w.checkpointWhitelist[key] = val
-		w.checkpointOrder = append(w.checkpointOrder, key)
+	entries := make([]rawdb.CheckpointWhitelistEntry, 0, len(w.checkpointOrder))
+	for _, number := range w.checkpointOrder {
+		entries = append(entries, rawdb.CheckpointWhitelistEntry{Number: number, Hash: w.checkpointWhitelist[number]})
 	}
+
+	rawdb.WriteBorCheckpointWhitelist(w.db, entries)
+}
+
+// ProcessMilestone whitelists the end block of the latest milestone, replacing
+// the previous one. Older milestones can't be replayed over a newer one.
+func (w *Service) ProcessMilestone(endBlockNum uint64, endBlockHash common.Hash) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if w.milestoneExists && endBlockNum < w.milestoneNumber {
+		return
+	}
+
+	log.Debug("Whitelisting new milestone", "block number", endBlockNum, "block hash", endBlockHash)
+
+	w.milestoneExists = true
+	w.milestoneNumber = endBlockNum
+	w.milestoneHash = endBlockHash
+}
+
+// GetWhitelistedMilestone returns whether a milestone is whitelisted, along
+// with its end block number and hash.
+func (w *Service) GetWhitelistedMilestone() (bool, uint64, common.Hash) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	return w.milestoneExists, w.milestoneNumber, w.milestoneHash
+}
+
+// PurgeMilestone removes the whitelisted milestone
+func (w *Service) PurgeMilestone() {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	w.milestoneExists = false
+	w.milestoneNumber = 0
+	w.milestoneHash = common.Hash{}
+}
+
+// EnqueueWhitelistBlock enqueues blockNumber, blockHash to the checkpoint whitelist map.
+// It returns false if the block was already whitelisted.
+func (w *Service) enqueueCheckpointWhitelist(key uint64, val common.Hash) bool {
+	if _, ok := w.checkpointWhitelist[key]; ok {
+		return false
+	}
+
+	log.Debug("Enqueing new checkpoint whitelist", "block number", key, "block hash", val)
+
+	w.checkpointWhitelist[key] = val
+	w.checkpointOrder = append(w.checkpointOrder, key)
+
+	return true
 }
 
 // DequeueWhitelistBlock dequeues block, blockhash from the checkpoint whitelist map
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -40,6 +41,33 @@ func TestWhitelistCheckpoint(t *testing.T) {
 	require.Equal(t, s.length(), 10, "expected 10 items in whitelist")
 }
 
+// TestPersistCheckpointWhitelist checks that the checkpoint whitelist
+// survives a restart
+func TestPersistCheckpointWhitelist(t *testing.T) {
+	t.Parallel()
+
+	db := rawdb.NewMemoryDatabase()
+
+	s := NewService(db, 3)
+	for i := uint64(1); i <= 4; i++ {
+		s.ProcessCheckpoint(i*256, common.Hash{byte(i)})
+	}
+
+	// the oldest entry is evicted from the database as well
+	s = NewService(db, 3)
+	require.Equal(t, []uint64{512, 768, 1024}, s.checkpointOrder)
+	require.Equal(t, common.Hash{0x4}, s.GetCheckpointWhitelist()[1024])
+
+	// the capacity may shrink between restarts
+	s = NewService(db, 2)
+	require.Equal(t, []uint64{768, 1024}, s.checkpointOrder)
+
+	s.PurgeCheckpointWhitelist()
+
+	s = NewService(db, 3)
+	require.Equal(t, 0, s.length(), "expected empty whitelist")
+}
+
 // TestIsValidPeer checks the IsValidPeer function in isolation
 // for different cases by providing a mock fetchHeadersByNumber function
 func TestIsValidPeer(t *testing.T) {
@@ -183,6 +211,129 @@ func TestIsValidChain(t *testing.T) {
 	require.Equal(t, err, nil, "expected error to be nil")
 }
 
+// TestWhitelistMilestone checks that only the latest milestone is kept
+func TestWhitelistMilestone(t *testing.T) {
+	t.Parallel()
+
+	s := NewMockService(10, 10)
+
+	exists, _, _ := s.GetWhitelistedMilestone()
+	require.False(t, exists, "expected no milestone")
+
+	s.ProcessMilestone(20, common.Hash{0x20})
+	s.ProcessMilestone(10, common.Hash{0x10}) // older milestones are ignored
+
+	exists, number, hash := s.GetWhitelistedMilestone()
+	require.True(t, exists, "expected a milestone")
+	require.Equal(t, uint64(20), number)
+	require.Equal(t, common.Hash{0x20}, hash)
+
+	s.PurgeMilestone()
+
+	exists, _, _ = s.GetWhitelistedMilestone()
+	require.False(t, exists, "expected no milestone")
+}
+
+// TestIsValidPeerMilestone checks that peers with another block at the
+// milestone height are rejected
+func TestIsValidPeerMilestone(t *testing.T) {
+	t.Parallel()
+
+	s := NewMockService(10, 10)
+	chain := createMockChain(1, 20)
+
+	fetchHeadersByNumber := func(number uint64, _ int, _ int, _ bool) ([]*types.Header, []common.Hash, error) {
+		if number == 0 || number > uint64(len(chain)) {
+			return nil, nil, nil
+		}
+
+		header := chain[number-1]
+
+		return []*types.Header{header}, []common.Hash{header.Hash()}, nil
+	}
+
+	s.ProcessMilestone(10, chain[9].Hash())
+
+	res, err := s.IsValidPeer(nil, fetchHeadersByNumber)
+	require.NoError(t, err, "expected no error")
+	require.True(t, res, "expected peer to be valid")
+
+	// the peer has another block at the milestone height
+	s.ProcessMilestone(15, common.Hash{0x1})
+
+	res, err = s.IsValidPeer(nil, fetchHeadersByNumber)
+	require.ErrorIs(t, err, ErrMilestoneMismatch)
+	require.False(t, res, "expected peer to be invalid")
+
+	// the peer doesn't have the milestone yet
+	s.ProcessMilestone(30, common.Hash{0x1})
+
+	res, err = s.IsValidPeer(nil, fetchHeadersByNumber)
+	require.ErrorIs(t, err, ErrNoRemoteMilestone)
+	require.False(t, res, "expected peer to be invalid")
+}
+
+// TestIsValidChainMilestone checks that chains conflicting with the
+// whitelisted milestone are rejected
+func TestIsValidChainMilestone(t *testing.T) {
+	t.Parallel()
+
+	db := rawdb.NewMemoryDatabase()
+	s := NewService(db, 10)
+
+	chainA := createMockLinkedChain(common.Hash{}, 1, 20)    // A1->A2...A19->A20
+	chainB := createMockLinkedChain(chainA[3].Hash(), 5, 25) // A4->B5->B6...B24->B25
+
+	// chain A is our canonical chain
+	for _, header := range chainA {
+		rawdb.WriteCanonicalHash(db, header.Hash(), header.Number.Uint64())
+	}
+
+	s.ProcessMilestone(10, chainA[9].Hash())
+
+	// case1: chain containing the milestone
+	res, err := s.IsValidChain(chainA[len(chainA)-1], chainA)
+	require.NoError(t, err, "expected no error")
+	require.True(t, res, "expected chain to be valid")
+
+	// case2: chain with another block at the milestone height
+	res, err = s.IsValidChain(chainA[len(chainA)-1], chainB)
+	require.ErrorIs(t, err, ErrMilestoneMismatch)
+	require.False(t, res, "expected chain to be invalid")
+
+	// case3: chain reorging below the milestone we have already reached
+	res, err = s.IsValidChain(chainA[len(chainA)-1], chainB[:3])
+	require.ErrorIs(t, err, ErrMilestoneMismatch)
+	require.False(t, res, "expected chain to be invalid")
+
+	// case4: chain whose parent below the milestone isn't canonical
+	res, err = s.IsValidChain(chainA[len(chainA)-1], chainB[1:3])
+	require.ErrorIs(t, err, ErrMilestoneMismatch)
+	require.False(t, res, "expected chain to be invalid")
+
+	// case5: same chain while we're still behind the milestone
+	res, err = s.IsValidChain(chainA[5], chainB[:3])
+	require.NoError(t, err, "expected no error")
+	require.True(t, res, "expected chain to be valid")
+
+	// case6: re-import of our own blocks below the milestone
+	res, err = s.IsValidChain(chainA[len(chainA)-1], chainA[2:6])
+	require.NoError(t, err, "expected no error")
+	require.True(t, res, "expected chain to be valid")
+
+	// case7: future chain after the milestone
+	res, err = s.IsValidChain(chainA[len(chainA)-1], createMockChain(21, 30))
+	require.NoError(t, err, "expected no error")
+	require.True(t, res, "expected chain to be valid")
+
+	// case8: chain reorging towards a milestone we don't have
+	s.ProcessMilestone(10, chainB[5].Hash())
+
+	res, err = s.IsValidChain(chainA[len(chainA)-1], chainB[:3])
+	require.NoError(t, err, "expected no error")
+	require.True(t, res, "expected chain to be valid")
+}
+
 func TestSplitChain(t *testing.T) {
 	t.Parallel()
 
@@ -400,6 +551,18 @@ func createMockChain(start, end uint64) []*types.Header {
 	return chain
 }
 
+// createMockLinkedChain creates a chain of headers linked by their parent hashes
+func createMockLinkedChain(parent common.Hash, start, end uint64) []*types.Header {
+	chain := createMockChain(start, end)
+
+	for _, header := range chain {
+		header.ParentHash = parent
+		parent = header.Hash()
+	}
+
+	return chain
+}
+
 // mXNM should be initialized
 func addTestCaseParams(mXNM map[int]map[int]map[int]struct{}, x, n, m int) {
 	//nolint:ineffassign
@@ -23,6 +23,7 @@ import (
 	"os/user"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -33,7 +34,11 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdall" //nolint:typecheck
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdallapp"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallcache"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallfailover"
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdallgrpc"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallreplay"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallstatus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
@@ -217,14 +222,17 @@ type Config struct {
 	// CheckpointOracle is the configuration for checkpoint oracle.
 	CheckpointOracle *params.CheckpointOracleConfig `toml:",omitempty"`
 
-	// URL to connect to Heimdall node
-	HeimdallURL string
+	// URLs to connect to Heimdall node, more than one endpoint enables failover
+	HeimdallURL []string
 
 	// No heimdall service
 	WithoutHeimdall bool
 
-	// Address to connect to Heimdall gRPC server
-	HeimdallgRPCAddress string
+	// Addresses to connect to Heimdall gRPC server, more than one endpoint enables failover
+	HeimdallgRPCAddress []string
+
+	// Verify every span against a second Heimdall endpoint before committing it
+	HeimdallCrossCheckSpans bool
 
 	// Run heimdall service as a child process
 	RunHeimdall bool
@@ -235,6 +243,15 @@ type Config struct {
 	// Use child heimdall process to fetch data, Only works when RunHeimdall is true
 	UseHeimdallApp bool
 
+	// Cache heimdall spans, state-sync events and checkpoints in the node database
+	HeimdallCache bool
+
+	// Record every heimdall response to this JSONL fixture file
+	HeimdallRecordFile string
+
+	// Serve heimdall responses from this JSONL fixture file, without network access
+	HeimdallReplayFile string
+
 	// Bor logs flag
 	BorLogs bool
 
@@ -275,12 +292,36 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, et
 				log.Warn("Sanitizing DevFakeAuthor", "Use DevFakeAuthor with", "--bor.withoutheimdall")
 			}
 			var heimdallClient bor.IHeimdallClient
+			if ethConfig.HeimdallReplayFile != "" {
+				replayClient, err := heimdallreplay.NewHeimdallReplayClient(ethConfig.HeimdallReplayFile)
+				if err != nil {
+					log.Crit("Failed to load Heimdall replay file", "file", ethConfig.HeimdallReplayFile, "err", err)
+				}
+
+				return bor.New(chainConfig, db, blockchainAPI, spanner, replayClient, genesisContractsClient, false)
+			}
+
 			if ethConfig.RunHeimdall && ethConfig.UseHeimdallApp {
 				heimdallClient = heimdallapp.NewHeimdallAppClient()
-			} else if ethConfig.HeimdallgRPCAddress != "" {
-				heimdallClient = heimdallgrpc.NewHeimdallGRPCClient(ethConfig.HeimdallgRPCAddress)
 			} else {
-				heimdallClient = heimdall.NewHeimdallClient(ethConfig.HeimdallURL)
+				heimdallClient = newHeimdallClient(ethConfig)
+			}
+
+			// Track the requests actually sent to heimdall, the cached ones say
+			// nothing about its reachability
+			heimdallClient = heimdallstatus.NewHeimdallStatusClient(heimdallClient)
+
+			if ethConfig.HeimdallCache {
+				heimdallClient = heimdallcache.NewHeimdallCacheClient(heimdallClient, db)
+			}
+
+			if ethConfig.HeimdallRecordFile != "" {
+				recordClient, err := heimdallreplay.NewHeimdallRecordClient(heimdallClient, ethConfig.HeimdallRecordFile)
+				if err != nil {
+					log.Crit("Failed to open Heimdall record file", "file", ethConfig.HeimdallRecordFile, "err", err)
+				}
+
+				heimdallClient = recordClient
 			}
 
 			return bor.New(chainConfig, db, blockchainAPI, spanner, heimdallClient, genesisContractsClient, false)
@@ -310,3 +351,55 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, et
 	}
 	return beacon.New(engine)
 }
+
+// newHeimdallClient creates a client for the configured Heimdall endpoints. The
+// gRPC addresses come first, followed by the HTTP urls, and if more than a single
+// endpoint is configured the client fails over between them in that order.
+func newHeimdallClient(ethConfig *Config) bor.IHeimdallClient {
+	endpoints := heimdallEndpoints(ethConfig)
+
+	if len(endpoints) == 0 {
+		return heimdall.NewHeimdallClient("")
+	}
+
+	if len(endpoints) == 1 {
+		if ethConfig.HeimdallCrossCheckSpans {
+			log.Warn("Span cross-checking requires more than one Heimdall endpoint")
+		}
+
+		return endpoints[0].Client
+	}
+
+	client, err := heimdallfailover.NewFailoverHeimdallClient(endpoints, ethConfig.HeimdallCrossCheckSpans)
+	if err != nil {
+		log.Crit("Failed to create Heimdall failover client", "err", err)
+	}
+
+	return client
+}
+
+// heimdallEndpoints builds the list of configured Heimdall endpoints, gRPC and
+// HTTP ones alike.
+func heimdallEndpoints(ethConfig *Config) []heimdallfailover.Endpoint {
+	endpoints := make([]heimdallfailover.Endpoint, 0, len(ethConfig.HeimdallgRPCAddress)+len(ethConfig.HeimdallURL))
+
+	for _, address := range ethConfig.HeimdallgRPCAddress {
+		if address = strings.TrimSpace(address); address != "" {
+			endpoints = append(endpoints, heimdallfailover.Endpoint{
+				Name:   address,
+				Client: heimdallgrpc.NewHeimdallGRPCClient(address),
+			})
+		}
+	}
+
+	for _, url := range ethConfig.HeimdallURL {
+		if url = strings.TrimSpace(url); url != "" {
+			endpoints = append(endpoints, heimdallfailover.Endpoint{
+				Name:   url,
+				Client: heimdall.NewHeimdallClient(url),
+			})
+		}
+	}
+
+	return endpoints
+}
@@ -129,6 +129,22 @@ func NewOracle(backend OracleBackend, params Config) *Oracle {
 	}
 }
 
+// SetConfig updates the sampling parameters and the price bounds of the oracle,
+// discarding the last suggested price. The history limits are left untouched.
+func (oracle *Oracle) SetConfig(params Config) {
+	updated := NewOracle(oracle.backend, params)
+
+	oracle.fetchLock.Lock()
+	defer oracle.fetchLock.Unlock()
+
+	oracle.checkBlocks, oracle.percentile = updated.checkBlocks, updated.percentile
+	oracle.maxPrice, oracle.ignorePrice = updated.maxPrice, updated.ignorePrice
+
+	oracle.cacheLock.Lock()
+	oracle.lastHead = common.Hash{}
+	oracle.cacheLock.Unlock()
+}
+
 func (oracle *Oracle) ProcessCache() {
 	headEvent := make(chan core.ChainHeadEvent, 1)
 	oracle.backend.SubscribeChainHeadEvent(headEvent)
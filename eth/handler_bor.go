@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -36,6 +37,18 @@ var (
 
 	// errEndBlock is returned when we're unable to fetch a block locally.
 	errEndBlock = errors.New("failed to get end block")
+
+	// errMilestone is returned when we are unable to fetch the
+	// latest milestone from the local heimdall.
+	errMilestone = errors.New("failed to fetch latest milestone")
+
+	// errMissingMilestone is returned when we don't have the
+	// milestone end block locally, yet.
+	errMissingMilestone = errors.New("missing milestone block")
+
+	// errMilestoneHashMismatch is returned when the local end block
+	// hash doesn't match with the hash in milestone.
+	errMilestoneHashMismatch = errors.New("milestone hash mismatch")
 )
 
 // fetchWhitelistCheckpoints fetches the latest checkpoint/s from it's local heimdall
@@ -101,3 +114,33 @@ func (h *ethHandler) fetchWhitelistCheckpoints(ctx context.Context, bor *bor.Bor
 
 	return blockNums, blockHashes, nil
 }
+
+// fetchWhitelistMilestone fetches the latest milestone from it's local heimdall
+// and verifies its end block against bor data.
+func (h *ethHandler) fetchWhitelistMilestone(ctx context.Context, bor *bor.Bor) (uint64, common.Hash, error) {
+	latest, err := bor.HeimdallClient.FetchMilestone(ctx)
+	if errors.Is(err, milestone.ErrNotSupported) {
+		return 0, common.Hash{}, err
+	}
+
+	if err != nil {
+		log.Debug("Failed to fetch latest milestone for whitelisting", "err", err)
+		return 0, common.Hash{}, errMilestone
+	}
+
+	endBlock := latest.EndBlock.Uint64()
+
+	// check if we have the milestone block
+	header := h.chain.GetHeaderByNumber(endBlock)
+	if header == nil {
+		log.Debug("Head block behind milestone block", "head", h.chain.CurrentHeader().Number, "milestone end block", endBlock)
+		return 0, common.Hash{}, errMissingMilestone
+	}
+
+	if header.Hash() != latest.Hash {
+		log.Warn("Milestone hash mismatch while whitelisting", "number", endBlock, "expected", latest.Hash, "got", header.Hash())
+		return 0, common.Hash{}, errMilestoneHashMismatch
+	}
+
+	return endBlock, latest.Hash, nil
+}
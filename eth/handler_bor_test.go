@@ -12,12 +12,14 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/bor"
 	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
 )
 
 type mockHeimdall struct {
 	fetchCheckpoint      func(ctx context.Context, number int64) (*checkpoint.Checkpoint, error)
 	fetchCheckpointCount func(ctx context.Context) (int64, error)
+	fetchMilestone       func(ctx context.Context) (*milestone.Milestone, error)
 }
 
 func (m *mockHeimdall) StateSyncEvents(ctx context.Context, fromID uint64, to int64) ([]*clerk.EventRecordWithTime, error) {
@@ -33,6 +35,12 @@ func (m *mockHeimdall) FetchCheckpoint(ctx context.Context, number int64) (*chec
 func (m *mockHeimdall) FetchCheckpointCount(ctx context.Context) (int64, error) {
 	return m.fetchCheckpointCount(ctx)
 }
+func (m *mockHeimdall) FetchMilestone(ctx context.Context) (*milestone.Milestone, error) {
+	return m.fetchMilestone(ctx)
+}
+func (m *mockHeimdall) FetchMilestoneCount(ctx context.Context) (int64, error) {
+	return 0, nil
+}
 func (m *mockHeimdall) Close() {}
 
 func TestFetchWhitelistCheckpoints(t *testing.T) {
@@ -132,3 +140,52 @@ func createMockCheckpoints(count int) []*checkpoint.Checkpoint {
 
 	return checkpoints
 }
+
+func TestFetchWhitelistMilestone(t *testing.T) {
+	t.Parallel()
+
+	th := newTestHandlerWithBlocks(20)
+	defer th.close()
+
+	handler := (*ethHandler)(th.handler)
+
+	var heimdall mockHeimdall
+
+	bor := &bor.Bor{HeimdallClient: &heimdall}
+
+	ctx := context.Background()
+
+	newMilestone := func(end uint64, hash common.Hash) func(context.Context) (*milestone.Milestone, error) {
+		return func(context.Context) (*milestone.Milestone, error) {
+			return &milestone.Milestone{StartBlock: big.NewInt(int64(end) - 3), EndBlock: new(big.Int).SetUint64(end), Hash: hash}, nil
+		}
+	}
+
+	// milestone matching the local chain
+	heimdall.fetchMilestone = newMilestone(16, th.chain.GetHeaderByNumber(16).Hash())
+
+	num, hash, err := handler.fetchWhitelistMilestone(ctx, bor)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), num)
+	require.Equal(t, th.chain.GetHeaderByNumber(16).Hash(), hash)
+
+	// milestone conflicting with the local chain
+	heimdall.fetchMilestone = newMilestone(16, common.Hash{0x1})
+
+	_, _, err = handler.fetchWhitelistMilestone(ctx, bor)
+	require.Equal(t, errMilestoneHashMismatch, err)
+
+	// milestone ahead of the local chain
+	heimdall.fetchMilestone = newMilestone(24, common.Hash{0x1})
+
+	_, _, err = handler.fetchWhitelistMilestone(ctx, bor)
+	require.Equal(t, errMissingMilestone, err)
+
+	// heimdall client without milestones
+	heimdall.fetchMilestone = func(context.Context) (*milestone.Milestone, error) {
+		return nil, milestone.ErrNotSupported
+	}
+
+	_, _, err = handler.fetchWhitelistMilestone(ctx, bor)
+	require.ErrorIs(t, err, milestone.ErrNotSupported)
+}
@@ -246,4 +246,7 @@ type ChainValidator interface {
 	ProcessCheckpoint(endBlockNum uint64, endBlockHash common.Hash)
 	GetCheckpointWhitelist() map[uint64]common.Hash
 	PurgeCheckpointWhitelist()
+	ProcessMilestone(endBlockNum uint64, endBlockHash common.Hash)
+	GetWhitelistedMilestone() (bool, uint64, common.Hash)
+	PurgeMilestone()
 }
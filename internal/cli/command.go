@@ -102,6 +102,11 @@ func Commands() map[string]MarkDownCommandFactory {
 				Meta2: meta2,
 			}, nil
 		},
+		"debug blockstm": func() (MarkDownCommand, error) {
+			return &DebugBlockstmCommand{
+				UI: ui,
+			}, nil
+		},
 		"chain": func() (MarkDownCommand, error) {
 			return &ChainCommand{
 				UI: ui,
@@ -167,6 +172,11 @@ func Commands() map[string]MarkDownCommandFactory {
 				Meta2: meta2,
 			}, nil
 		},
+		"heimdall-simulator": func() (MarkDownCommand, error) {
+			return &HeimdallSimulatorCommand{
+				UI: ui,
+			}, nil
+		},
 		"fingerprint": func() (MarkDownCommand, error) {
 			return &FingerprintCommand{
 				UI: ui,
@@ -189,6 +199,11 @@ func Commands() map[string]MarkDownCommandFactory {
 				Meta2: meta2,
 			}, nil
 		},
+		"state-sync": func() (MarkDownCommand, error) {
+			return &StateSyncCommand{
+				UI: ui,
+			}, nil
+		},
 		"snapshot": func() (MarkDownCommand, error) {
 			return &SnapshotCommand{
 				UI: ui,
@@ -199,6 +214,21 @@ func Commands() map[string]MarkDownCommandFactory {
 				Meta: meta,
 			}, nil
 		},
+		"whitelist": func() (MarkDownCommand, error) {
+			return &WhitelistCommand{
+				UI: ui,
+			}, nil
+		},
+		"whitelist list": func() (MarkDownCommand, error) {
+			return &WhitelistListCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"whitelist purge": func() (MarkDownCommand, error) {
+			return &WhitelistPurgeCommand{
+				Meta2: meta2,
+			}, nil
+		},
 	}
 }
 
@@ -51,6 +51,7 @@ func (d *DebugCommand) MarkDown() string {
 		"The ```bor debug``` command takes a debug dump of the running client.",
 		"- [```bor debug pprof```](./debug_pprof.md): Dumps bor pprof traces.",
 		"- [```bor debug block <number>```](./debug_block.md): Dumps bor block traces.",
+		"- [```bor debug blockstm```](./debug_blockstm.md): Profiles the parallel execution of a block.",
 	}
 	items = append(items, examples...)
 
@@ -69,7 +70,11 @@ func (c *DebugCommand) Help() string {
 
 	Get the block traces:
 
-		$ bor debug block <number>`
+		$ bor debug block <number>
+
+	Profile the parallel execution of a block:
+
+		$ bor debug blockstm --block <number>`
 }
 
 // Synopsis implements the cli.Command interface
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/blockstm"
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/mitchellh/cli"
+)
+
+// DebugBlockstmCommand is the command to profile the parallel execution of a block
+type DebugBlockstmCommand struct {
+	UI cli.Ui
+
+	rpcURL string
+	block  string
+	format string
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *DebugBlockstmCommand) MarkDown() string {
+	items := []string{
+		"# Debug blockstm",
+		"The ```bor debug blockstm``` command re-executes a block with block-stm and prints its transaction dependencies, " +
+			"re-executions and critical path, as a table or as a graphviz DOT graph.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *DebugBlockstmCommand) Help() string {
+	return `Usage: bor debug blockstm [--block <number>] [--format text|dot]
+
+  Profile the parallel execution of a block ` + c.Flags().Help()
+}
+
+func (c *DebugBlockstmCommand) Flags() *flagset.Flagset {
+	flags := flagset.NewFlagSet("debug blockstm")
+
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "rpc",
+		Default: "http://localhost:8545",
+		Usage:   "RPC endpoint of the bor node, with the debug namespace enabled",
+		Value:   &c.rpcURL,
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "block",
+		Default: "latest",
+		Usage:   "Number or tag of the block to profile",
+		Value:   &c.block,
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "format",
+		Default: "text",
+		Usage:   "Output format, either text or dot",
+		Value:   &c.format,
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DebugBlockstmCommand) Synopsis() string {
+	return "Profile the parallel execution of a block"
+}
+
+// Run implements the cli.Command interface
+func (c *DebugBlockstmCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if c.format != "text" && c.format != "dot" {
+		c.UI.Error(fmt.Sprintf("unknown format %q", c.format))
+		return 1
+	}
+
+	// accept decimal numbers on top of the hex numbers and tags of the rpc
+	var number rpc.BlockNumber
+	if n, err := strconv.ParseInt(c.block, 10, 64); err == nil {
+		number = rpc.BlockNumber(n)
+	} else if err := number.UnmarshalJSON([]byte(c.block)); err != nil {
+		c.UI.Error(fmt.Sprintf("invalid block %q: %v", c.block, err))
+		return 1
+	}
+
+	ctx := context.Background()
+
+	client, err := rpc.DialContext(ctx, c.rpcURL)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("failed to connect to bor rpc: %v", err))
+		return 1
+	}
+	defer client.Close()
+
+	var profile blockstm.Profile
+	if err := client.CallContext(ctx, &profile, "debug_blockstmProfile", number); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if c.format == "dot" {
+		c.UI.Output(profile.DOT())
+		return 0
+	}
+
+	c.UI.Output(formatBlockstmProfile(&profile))
+
+	return 0
+}
+
+func formatBlockstmProfile(profile *blockstm.Profile) string {
+	summary := formatKV([]string{
+		fmt.Sprintf("Block|%d", profile.BlockNumber),
+		fmt.Sprintf("Hash|%s", profile.BlockHash),
+		fmt.Sprintf("Transactions|%d", len(profile.Transactions)),
+		fmt.Sprintf("Total gas|%d", profile.TotalGas),
+		fmt.Sprintf("Critical path gas|%d", profile.CriticalPathGas),
+		fmt.Sprintf("Critical path|%s", formatInts(profile.CriticalPath, "->")),
+		fmt.Sprintf("Speedup|%.2f", profile.Speedup),
+	})
+
+	if len(profile.Transactions) == 0 {
+		return summary
+	}
+
+	rows := make([]string, len(profile.Transactions)+1)
+	rows[0] = "Index|Hash|Gas|Incarnations|Aborts|Dependencies"
+
+	for i, tx := range profile.Transactions {
+		rows[i+1] = fmt.Sprintf("%d|%s|%d|%d|%d|%s",
+			tx.Index,
+			tx.Hash,
+			tx.GasUsed,
+			tx.Incarnations,
+			tx.Aborts,
+			formatInts(tx.Dependencies, ","),
+		)
+	}
+
+	return summary + "\n\n" + formatList(rows)
+}
+
+func formatInts(ints []int, sep string) string {
+	if len(ints) == 0 {
+		return emptyPlaceHolder
+	}
+
+	strs := make([]string, len(ints))
+	for i, v := range ints {
+		strs[i] = fmt.Sprint(v)
+	}
+
+	return strings.Join(strs, sep)
+}
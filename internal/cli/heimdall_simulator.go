@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallsim"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/mitchellh/cli"
+)
+
+// HeimdallSimulatorCommand is the command to run a fake heimdall for devnets
+type HeimdallSimulatorCommand struct {
+	UI cli.Ui
+
+	listenAddr       string
+	rpcURL           string
+	chainID          string
+	validators       []string
+	spanLength       uint64
+	producerCount    uint64
+	checkpointLength uint64
+	milestoneLength  uint64
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *HeimdallSimulatorCommand) MarkDown() string {
+	items := []string{
+		"# Heimdall simulator",
+		"The ```heimdall-simulator``` command runs a fake Heimdall serving the REST endpoints used by bor, for devnets and tests. " +
+			"Spans rotate through the given validator set, state-sync events are injected with a ```POST``` to ```/clerk/event-record``` " +
+			"and checkpoints and milestones are built from the chain of the bor node given by ```rpc```.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *HeimdallSimulatorCommand) Help() string {
+	return `Usage: bor heimdall-simulator --validators <address>:<power>,...
+
+  Run a fake Heimdall serving spans, state-sync events, checkpoints and milestones
+
+  Inject a state-sync event with:
+
+    $ curl -X POST -d '{"contract": "0x...", "data": "0x..."}' http://localhost:1317/clerk/event-record ` + c.Flags().Help()
+}
+
+func (c *HeimdallSimulatorCommand) Flags() *flagset.Flagset {
+	flags := flagset.NewFlagSet("heimdall-simulator")
+
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "listen-addr",
+		Default: "localhost:1317",
+		Usage:   "Address the simulator serves the Heimdall REST endpoints on",
+		Value:   &c.listenAddr,
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "rpc",
+		Default: "",
+		Usage:   "RPC endpoint of a bor node to build checkpoints and milestones from (none if empty)",
+		Value:   &c.rpcURL,
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "chain-id",
+		Default: "15001",
+		Usage:   "Bor chain id reported in spans, events and checkpoints",
+		Value:   &c.chainID,
+	})
+	flags.SliceStringFlag(&flagset.SliceStringFlag{
+		Name:    "validators",
+		Default: []string{},
+		Usage:   "Comma separated validator set as <address>:<power> pairs",
+		Value:   &c.validators,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "span-length",
+		Default: 6400,
+		Usage:   "Number of blocks per span",
+		Value:   &c.spanLength,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "producer-count",
+		Default: 0,
+		Usage:   "Number of selected producers per span (all validators if 0)",
+		Value:   &c.producerCount,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "checkpoint-length",
+		Default: 256,
+		Usage:   "Number of blocks per checkpoint",
+		Value:   &c.checkpointLength,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "milestone-length",
+		Default: 16,
+		Usage:   "Number of blocks per milestone",
+		Value:   &c.milestoneLength,
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (c *HeimdallSimulatorCommand) Synopsis() string {
+	return "Run a fake Heimdall for devnets"
+}
+
+// Run implements the cli.Command interface
+func (c *HeimdallSimulatorCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	validators, err := parseValidators(c.validators)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	config := heimdallsim.Config{
+		ChainID:          c.chainID,
+		Validators:       validators,
+		SpanLength:       c.spanLength,
+		ProducerCount:    int(c.producerCount),
+		CheckpointLength: c.checkpointLength,
+		MilestoneLength:  c.milestoneLength,
+	}
+
+	var chain heimdallsim.ChainReader
+
+	if c.rpcURL != "" {
+		client, err := ethclient.Dial(c.rpcURL)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("failed to connect to bor rpc: %v", err))
+			return 1
+		}
+		defer client.Close()
+
+		chain = client
+	}
+
+	sim, err := heimdallsim.New(config, chain)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	listener, err := net.Listen("tcp", c.listenAddr)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("failed to listen on '%s': %v", c.listenAddr, err))
+		return 1
+	}
+
+	srv := &http.Server{Handler: sim.Handler()}
+
+	go func() {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("Heimdall simulator failed", "err", err)
+		}
+	}()
+
+	c.UI.Output(fmt.Sprintf("Heimdall simulator listening on http://%s", listener.Addr()))
+
+	signalCh := make(chan os.Signal, 4)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+
+	sig := <-signalCh
+
+	c.UI.Output(fmt.Sprintf("Caught signal: %v", sig))
+	c.UI.Output("Gracefully shutting down simulator...")
+
+	if err := srv.Close(); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	return 0
+}
+
+// parseValidators parses a list of <address>:<power> pairs
+func parseValidators(list []string) ([]*valset.Validator, error) {
+	validators := make([]*valset.Validator, 0, len(list))
+
+	for i, item := range list {
+		parts := strings.Split(item, ":")
+		if len(parts) != 2 || !common.IsHexAddress(parts[0]) {
+			return nil, fmt.Errorf("invalid validator '%s', expected <address>:<power>", item)
+		}
+
+		power, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid voting power of validator '%s': %v", item, err)
+		}
+
+		validator := valset.NewValidator(common.HexToAddress(parts[0]), power)
+		validator.ID = uint64(i + 1)
+
+		validators = append(validators, validator)
+	}
+
+	if len(validators) == 0 {
+		return nil, errors.New("no validators provided")
+	}
+
+	return validators, nil
+}
@@ -55,39 +55,44 @@ func (c *Command) Synopsis() string {
 }
 
 func (c *Command) extractFlags(args []string) error {
-	config := *DefaultConfig()
-
 	flags := c.Flags()
 	if err := flags.Parse(args); err != nil {
 		c.UI.Error(err.Error())
-		c.config = &config
+		c.config = DefaultConfig()
+
+		return err
+	}
+
+	config, err := c.readConfig(args)
+	c.config = config
 
+	if err != nil {
+		c.UI.Error(err.Error())
 		return err
 	}
 
+	return nil
+}
+
+// readConfig merges the parsed cli flags and the config file, if provided, into
+// the default config. It's used both on startup and when the config is reloaded.
+func (c *Command) readConfig(args []string) (*Config, error) {
+	config := *DefaultConfig()
+
 	// TODO: Check if this can be removed or not
 	// read cli flags
 	if err := config.Merge(c.cliConfig); err != nil {
-		c.UI.Error(err.Error())
-		c.config = &config
-
-		return err
+		return &config, err
 	}
 	// read if config file is provided, this will overwrite the cli flags, if provided
 	if c.configFile != "" {
 		log.Warn("Config File provided, this will overwrite the cli flags", "path", c.configFile)
 		cfg, err := readConfigFile(c.configFile)
 		if err != nil {
-			c.UI.Error(err.Error())
-			c.config = &config
-
-			return err
+			return &config, err
 		}
 		if err := config.Merge(cfg); err != nil {
-			c.UI.Error(err.Error())
-			c.config = &config
-
-			return err
+			return &config, err
 		}
 	}
 
@@ -117,9 +122,7 @@ func (c *Command) extractFlags(args []string) error {
 		}
 	}
 
-	c.config = &config
-
-	return nil
+	return &config, nil
 }
 
 // Run implements the cli.Command interface
@@ -139,7 +142,14 @@ func (c *Command) Run(args []string) int {
 		}()
 	}
 
-	srv, err := NewServer(c.config, WithGRPCAddress())
+	opts := []serverOption{WithGRPCAddress()}
+	if c.configFile != "" {
+		opts = append(opts, WithConfigReader(func() (*Config, error) {
+			return c.readConfig(args)
+		}))
+	}
+
+	srv, err := NewServer(c.config, opts...)
 	if err != nil {
 		c.UI.Error(err.Error())
 		return 1
@@ -153,7 +163,24 @@ func (c *Command) handleSignals() int {
 	signalCh := make(chan os.Signal, 4)
 	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
 
-	sig := <-signalCh
+	var sig os.Signal
+
+	// reload the config on SIGHUP, shut down on any other signal
+	for sig = range signalCh {
+		if sig != syscall.SIGHUP {
+			break
+		}
+
+		c.UI.Output("Caught signal: hangup, reloading config...")
+
+		applied, ignored, err := c.srv.reloadConfig()
+		if err != nil {
+			log.Error("Failed to reload config", "err", err)
+			continue
+		}
+
+		c.UI.Output(fmt.Sprintf("Applied: %v, ignored (restart required): %v", applied, ignored))
+	}
 
 	c.UI.Output(fmt.Sprintf("Caught signal: %v", sig))
 	c.UI.Output("Gracefully shutting down agent...")
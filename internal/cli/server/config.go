@@ -25,10 +25,12 @@ import (
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/fdlimit"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
 	"github.com/ethereum/go-ethereum/internal/cli/server/chains"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
@@ -236,15 +238,50 @@ type P2PDiscovery struct {
 	DNS []string `hcl:"dns,optional" toml:"dns,optional"`
 }
 
+// defaultHeimdallURL is the heimdall server used without any url nor grpc address
+const defaultHeimdallURL = "http://localhost:1317"
+
+// stringList is a list of strings which can also be set as a comma separated
+// string in the toml config files, like the options which used to be strings.
+type stringList []string
+
+// UnmarshalTOML implements toml.Unmarshaler
+func (l *stringList) UnmarshalTOML(data interface{}) error {
+	switch value := data.(type) {
+	case string:
+		*l = flagset.SplitAndTrim(value)
+	case []interface{}:
+		list := make(stringList, 0, len(value))
+
+		for _, item := range value {
+			s, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("invalid list item %v, expected a string", item)
+			}
+
+			list = append(list, s)
+		}
+
+		*l = list
+	default:
+		return fmt.Errorf("invalid value %v, expected a string or a list of strings", data)
+	}
+
+	return nil
+}
+
 type HeimdallConfig struct {
-	// URL is the url of the heimdall server
-	URL string `hcl:"url,optional" toml:"url,optional"`
+	// URL is the list of urls of the heimdall server, more than one endpoint enables failover
+	URL stringList `hcl:"url,optional" toml:"url,optional"`
 
 	// Without is used to disable remote heimdall during testing
 	Without bool `hcl:"bor.without,optional" toml:"bor.without,optional"`
 
-	// GRPCAddress is the address of the heimdall grpc server
-	GRPCAddress string `hcl:"grpc-address,optional" toml:"grpc-address,optional"`
+	// GRPCAddress is the list of addresses of the heimdall grpc server, failed over to along with the urls
+	GRPCAddress stringList `hcl:"grpc-address,optional" toml:"grpc-address,optional"`
+
+	// CrossCheckSpans is used to verify every span against a second heimdall endpoint before committing it
+	CrossCheckSpans bool `hcl:"cross-check-spans,optional" toml:"cross-check-spans,optional"`
 
 	// RunHeimdall is used to run heimdall as a child process
 	RunHeimdall bool `hcl:"bor.runheimdall,optional" toml:"bor.runheimdall,optional"`
@@ -254,6 +291,15 @@ type HeimdallConfig struct {
 
 	// UseHeimdallApp is used to fetch data from heimdall app when running heimdall as a child process
 	UseHeimdallApp bool `hcl:"bor.useheimdallapp,optional" toml:"bor.useheimdallapp,optional"`
+
+	// Cache is used to persist heimdall responses in the node database and serve repeat queries locally
+	Cache bool `hcl:"cache,optional" toml:"cache,optional"`
+
+	// Record is the path of a JSONL file every heimdall response gets recorded to
+	Record string `hcl:"record,optional" toml:"record,optional"`
+
+	// Replay is the path of a JSONL file recorded earlier, to serve heimdall responses from without network access
+	Replay string `hcl:"replay,optional" toml:"replay,optional"`
 }
 
 type TxPoolConfig struct {
@@ -270,6 +316,19 @@ type TxPoolConfig struct {
 	Rejournal    time.Duration `hcl:"-,optional" toml:"-"`
 	RejournalRaw string        `hcl:"rejournal,optional" toml:"rejournal,optional"`
 
+	// Snapshot is the path to store the pending and queued transactions of all accounts to survive node restarts
+	Snapshot string `hcl:"snapshot,optional" toml:"snapshot,optional"`
+
+	// Resnapshot is the time interval to regenerate the transaction pool snapshot
+	Resnapshot    time.Duration `hcl:"-,optional" toml:"-"`
+	ResnapshotRaw string        `hcl:"resnapshot,optional" toml:"resnapshot,optional"`
+
+	// SnapshotAccountSlots is the maximum number of transactions per account in the snapshot
+	SnapshotAccountSlots uint64 `hcl:"snapshotaccountslots,optional" toml:"snapshotaccountslots,optional"`
+
+	// SnapshotGlobalSlots is the maximum number of transactions for all accounts in the snapshot
+	SnapshotGlobalSlots uint64 `hcl:"snapshotglobalslots,optional" toml:"snapshotglobalslots,optional"`
+
 	// PriceLimit is the minimum gas price to enforce for acceptance into the pool
 	PriceLimit uint64 `hcl:"pricelimit,optional" toml:"pricelimit,optional"`
 
@@ -314,6 +373,15 @@ type SealerConfig struct {
 	Recommit            time.Duration `hcl:"-,optional" toml:"-"`
 	RecommitRaw         string        `hcl:"recommit,optional" toml:"recommit,optional"`
 	CommitInterruptFlag bool          `hcl:"commitinterrupt,optional" toml:"commitinterrupt,optional"`
+
+	// ParallelBuild executes the transactions in parallel with block-stm when building blocks
+	ParallelBuild bool `hcl:"parallel,optional" toml:"parallel,optional"`
+
+	// RemoteSigner is the endpoint of the external signer used to seal blocks
+	RemoteSigner string `hcl:"remotesigner,optional" toml:"remotesigner,optional"`
+
+	// RemoteSignerType is the type of the external signer, clef or web3signer
+	RemoteSignerType string `hcl:"remotesignertype,optional" toml:"remotesignertype,optional"`
 }
 
 type JsonRPCConfig struct {
@@ -347,9 +415,58 @@ type JsonRPCConfig struct {
 
 	HttpTimeout *HttpTimeouts `hcl:"timeouts,block" toml:"timeouts,block"`
 
+	// Quota has the cost based quotas of the http and websocket clients
+	Quota *RPCQuotaConfig `hcl:"quota,block" toml:"quota,block"`
+
+	// Health has the settings of the health and readiness endpoints of the http server
+	Health *HealthConfig `hcl:"health,block" toml:"health,block"`
+
 	AllowUnprotectedTxs bool `hcl:"allow-unprotected-txs,optional" toml:"allow-unprotected-txs,optional"`
 }
 
+type RPCQuotaConfig struct {
+	// Budget is the cost each client can spend per period (0 = no quota)
+	Budget float64 `hcl:"budget,optional" toml:"budget,optional"`
+
+	// Period is the time over which the budget of the clients is refilled
+	Period    time.Duration `hcl:"-,optional" toml:"-"`
+	PeriodRaw string        `hcl:"period,optional" toml:"period,optional"`
+
+	// DefaultCost is the cost of the methods without a specific one
+	DefaultCost float64 `hcl:"defaultcost,optional" toml:"defaultcost,optional"`
+
+	// Costs are the costs of specific methods
+	Costs map[string]float64 `hcl:"costs,optional" toml:"costs,optional"`
+
+	// LogsBlockCost is the additional cost of eth_getLogs per block of the queried range
+	LogsBlockCost float64 `hcl:"logsblockcost,optional" toml:"logsblockcost,optional"`
+
+	// Header is the http header with the API key identifying the clients, by IP if empty or missing
+	Header string `hcl:"header,optional" toml:"header,optional"`
+
+	// Keys are the API keys of the known clients, the others are identified by IP
+	Keys []string `hcl:"keys,optional" toml:"keys,optional"`
+}
+
+type HealthConfig struct {
+	// Enabled serves the /health and /ready endpoints on the http server
+	Enabled bool `hcl:"enabled,optional" toml:"enabled,optional"`
+
+	// MaxSyncDistance is the number of blocks the node can be behind its peers while ready
+	MaxSyncDistance uint64 `hcl:"maxsyncdistance,optional" toml:"maxsyncdistance,optional"`
+
+	// MaxBlockAge is the age of the last block beyond which the node isn't ready
+	MaxBlockAge    time.Duration `hcl:"-,optional" toml:"-"`
+	MaxBlockAgeRaw string        `hcl:"maxblockage,optional" toml:"maxblockage,optional"`
+
+	// MinPeers is the number of peers below which the node isn't healthy
+	MinPeers uint64 `hcl:"minpeers,optional" toml:"minpeers,optional"`
+
+	// HeimdallTimeout is the time a request can wait for heimdall before it's deemed unreachable
+	HeimdallTimeout    time.Duration `hcl:"-,optional" toml:"-"`
+	HeimdallTimeoutRaw string        `hcl:"heimdalltimeout,optional" toml:"heimdalltimeout,optional"`
+}
+
 type AUTHConfig struct {
 	// JWTSecret is the hex-encoded jwt secret.
 	JWTSecret string `hcl:"jwtsecret,optional" toml:"jwtsecret,optional"`
@@ -575,6 +692,8 @@ type ParallelEVMConfig struct {
 	Enable bool `hcl:"enable,optional" toml:"enable,optional"`
 
 	SpeculativeProcesses int `hcl:"procs,optional" toml:"procs,optional"`
+
+	VerifyDependencies bool `hcl:"verifydeps,optional" toml:"verifydeps,optional"`
 }
 
 func DefaultConfig() *Config {
@@ -615,9 +734,13 @@ func DefaultConfig() *Config {
 			},
 		},
 		Heimdall: &HeimdallConfig{
-			URL:         "http://localhost:1317",
-			Without:     false,
-			GRPCAddress: "",
+			URL:             stringList{},
+			Without:         false,
+			GRPCAddress:     stringList{},
+			CrossCheckSpans: false,
+			Cache:           false,
+			Record:          "",
+			Replay:          "",
 		},
 		SyncMode: "full",
 		GcMode:   "full",
@@ -635,6 +758,11 @@ func DefaultConfig() *Config {
 			AccountQueue: 16,
 			GlobalQueue:  32768,
 			LifeTime:     3 * time.Hour,
+
+			Snapshot:             "",
+			Resnapshot:           10 * time.Minute,
+			SnapshotAccountSlots: core.DefaultTxPoolConfig.SnapshotAccountSlots,
+			SnapshotGlobalSlots:  65536,
 		},
 		Sealer: &SealerConfig{
 			Enabled:             false,
@@ -644,6 +772,9 @@ func DefaultConfig() *Config {
 			ExtraData:           "",
 			Recommit:            125 * time.Second,
 			CommitInterruptFlag: true,
+			ParallelBuild:       false,
+			RemoteSigner:        "",
+			RemoteSignerType:    "clef",
 		},
 		Gpo: &GpoConfig{
 			Blocks:           20,
@@ -691,6 +822,28 @@ func DefaultConfig() *Config {
 				WriteTimeout: 30 * time.Second,
 				IdleTimeout:  120 * time.Second,
 			},
+			Quota: &RPCQuotaConfig{
+				Budget:      0,
+				Period:      time.Minute,
+				DefaultCost: 1,
+				Costs: map[string]float64{
+					"debug_traceBlock":         100,
+					"debug_traceBlockByHash":   100,
+					"debug_traceBlockByNumber": 100,
+					"debug_traceCall":          20,
+					"debug_traceTransaction":   20,
+				},
+				LogsBlockCost: 0.1,
+				Header:        "",
+				Keys:          []string{},
+			},
+			Health: &HealthConfig{
+				Enabled:         false,
+				MaxSyncDistance: 10,
+				MaxBlockAge:     time.Minute,
+				MinPeers:        1,
+				HeimdallTimeout: 30 * time.Second,
+			},
 			Auth: &AUTHConfig{
 				JWTSecret: "",
 				Port:      node.DefaultAuthPort,
@@ -759,6 +912,7 @@ func DefaultConfig() *Config {
 		ParallelEVM: &ParallelEVMConfig{
 			Enable:               true,
 			SpeculativeProcesses: 8,
+			VerifyDependencies:   false,
 		},
 	}
 }
@@ -811,8 +965,12 @@ func (c *Config) fillTimeDurations() error {
 		{"jsonrpc.timeouts.idle", &c.JsonRPC.HttpTimeout.IdleTimeout, &c.JsonRPC.HttpTimeout.IdleTimeoutRaw},
 		{"jsonrpc.ws.ep-requesttimeout", &c.JsonRPC.Ws.ExecutionPoolRequestTimeout, &c.JsonRPC.Ws.ExecutionPoolRequestTimeoutRaw},
 		{"jsonrpc.http.ep-requesttimeout", &c.JsonRPC.Http.ExecutionPoolRequestTimeout, &c.JsonRPC.Http.ExecutionPoolRequestTimeoutRaw},
+		{"jsonrpc.quota.period", &c.JsonRPC.Quota.Period, &c.JsonRPC.Quota.PeriodRaw},
+		{"jsonrpc.health.maxblockage", &c.JsonRPC.Health.MaxBlockAge, &c.JsonRPC.Health.MaxBlockAgeRaw},
+		{"jsonrpc.health.heimdalltimeout", &c.JsonRPC.Health.HeimdallTimeout, &c.JsonRPC.Health.HeimdallTimeoutRaw},
 		{"txpool.lifetime", &c.TxPool.LifeTime, &c.TxPool.LifeTimeRaw},
 		{"txpool.rejournal", &c.TxPool.Rejournal, &c.TxPool.RejournalRaw},
+		{"txpool.resnapshot", &c.TxPool.Resnapshot, &c.TxPool.ResnapshotRaw},
 		{"cache.rejournal", &c.Cache.Rejournal, &c.Cache.RejournalRaw},
 		{"cache.timeout", &c.Cache.TrieTimeout, &c.Cache.TrieTimeoutRaw},
 		{"p2p.txarrivalwait", &c.P2P.TxArrivalWait, &c.P2P.TxArrivalWaitRaw},
@@ -895,9 +1053,19 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 	n.HeimdallURL = c.Heimdall.URL
 	n.WithoutHeimdall = c.Heimdall.Without
 	n.HeimdallgRPCAddress = c.Heimdall.GRPCAddress
+
+	// the local heimdall server is only the default without a grpc address,
+	// which takes precedence over it
+	if len(n.HeimdallURL) == 0 && len(n.HeimdallgRPCAddress) == 0 {
+		n.HeimdallURL = []string{defaultHeimdallURL}
+	}
+	n.HeimdallCrossCheckSpans = c.Heimdall.CrossCheckSpans
 	n.RunHeimdall = c.Heimdall.RunHeimdall
 	n.RunHeimdallArgs = c.Heimdall.RunHeimdallArgs
 	n.UseHeimdallApp = c.Heimdall.UseHeimdallApp
+	n.HeimdallCache = c.Heimdall.Cache
+	n.HeimdallRecordFile = c.Heimdall.Record
+	n.HeimdallReplayFile = c.Heimdall.Replay
 
 	// Developer Fake Author for producing blocks without authorisation on bor consensus
 	n.DevFakeAuthor = c.DevFakeAuthor
@@ -929,6 +1097,10 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 		n.TxPool.AccountQueue = c.TxPool.AccountQueue
 		n.TxPool.GlobalQueue = c.TxPool.GlobalQueue
 		n.TxPool.Lifetime = c.TxPool.LifeTime
+		n.TxPool.Snapshot = c.TxPool.Snapshot
+		n.TxPool.Resnapshot = c.TxPool.Resnapshot
+		n.TxPool.SnapshotAccountSlots = c.TxPool.SnapshotAccountSlots
+		n.TxPool.SnapshotGlobalSlots = c.TxPool.SnapshotGlobalSlots
 	}
 
 	// miner options
@@ -938,6 +1110,7 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 		n.Miner.GasCeil = c.Sealer.GasCeil
 		n.Miner.ExtraData = []byte(c.Sealer.ExtraData)
 		n.Miner.CommitInterruptFlag = c.Sealer.CommitInterruptFlag
+		n.Miner.ParallelBuild = c.Sealer.ParallelBuild
 
 		if etherbase := c.Sealer.Etherbase; etherbase != "" {
 			if !common.IsHexAddress(etherbase) {
@@ -1145,6 +1318,7 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 
 	n.ParallelEVM.Enable = c.ParallelEVM.Enable
 	n.ParallelEVM.SpeculativeProcesses = c.ParallelEVM.SpeculativeProcesses
+	n.ParallelEVM.VerifyDependencies = c.ParallelEVM.VerifyDependencies
 	n.RPCReturnDataLimit = c.RPCReturnDataLimit
 
 	if c.Ancient != "" {
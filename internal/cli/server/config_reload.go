@@ -0,0 +1,216 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var errNoConfigReader = errors.New("the configuration can't be reloaded, no configuration file was provided")
+
+// reloadable maps the configuration fields which can be changed at runtime to
+// the live component they are applied to. Changes to any other field require
+// a restart.
+var reloadable = map[string]string{
+	"verbosity":         "logger",
+	"log-level":         "logger",
+	"log.vmodule":       "logger",
+	"txpool.pricelimit": "txpool",
+	"miner.gasprice":    "gasprice",
+	"miner.gaslimit":    "miner",
+	"miner.recommit":    "miner",
+	"jsonrpc.gascap":    "jsonrpc",
+	"gpo.blocks":        "gpo",
+	"gpo.percentile":    "gpo",
+	"gpo.maxprice":      "gpo",
+	"gpo.ignoreprice":   "gpo",
+}
+
+// reloadOrder is the order the components are applied in. The miner gas price
+// is also set to the pool, so it goes first for the pool price limit to win.
+var reloadOrder = []string{"logger", "gasprice", "txpool", "miner", "jsonrpc", "gpo"}
+
+// configChange is a field whose value differs between two configurations.
+type configChange struct {
+	name     string
+	old, new reflect.Value
+}
+
+// WithConfigReader sets the function re-reading the configuration when it's
+// reloaded.
+func WithConfigReader(read func() (*Config, error)) serverOption {
+	return func(srv *Server, _ *Config) error {
+		srv.readConfig = read
+		return nil
+	}
+}
+
+// reloadConfig re-reads the configuration and applies the changed fields which
+// can be changed at runtime to the live node. The names of the applied fields
+// and of the ignored ones, which require a restart, are returned.
+func (s *Server) reloadConfig() ([]string, []string, error) {
+	if s.readConfig == nil {
+		return nil, nil, errNoConfigReader
+	}
+
+	config, err := s.readConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := config.loadChain(); err != nil {
+		return nil, nil, err
+	}
+
+	s.reloadLock.Lock()
+	defer s.reloadLock.Unlock()
+
+	var (
+		applied    []string
+		ignored    []string
+		components = make(map[string]bool)
+	)
+
+	for _, change := range diffConfig(s.config, config) {
+		component, ok := reloadable[change.name]
+		if !ok {
+			ignored = append(ignored, change.name)
+			continue
+		}
+
+		change.old.Set(change.new)
+
+		applied = append(applied, change.name)
+		components[component] = true
+	}
+
+	for _, component := range reloadOrder {
+		if !components[component] {
+			continue
+		}
+
+		if err := s.applyConfig(component); err != nil {
+			return applied, ignored, fmt.Errorf("failed to apply the %s configuration: %v", component, err)
+		}
+	}
+
+	log.Info("Reloaded configuration", "applied", applied, "ignored", ignored)
+
+	return applied, ignored, nil
+}
+
+// applyConfig applies the current configuration to the given live component.
+func (s *Server) applyConfig(component string) error {
+	config := s.config
+
+	switch component {
+	case "logger":
+		lvl, err := log.LvlFromString(VerbosityIntToString(config.Verbosity))
+		if err != nil {
+			return err
+		}
+
+		glogger.Verbosity(lvl)
+
+		return glogger.Vmodule(config.Logging.Vmodule)
+
+	case "txpool":
+		s.backend.TxPool().SetGasPrice(new(big.Int).SetUint64(config.TxPool.PriceLimit))
+
+	case "gasprice":
+		if config.Sealer.GasPrice != nil {
+			s.backend.SetGasPrice(config.Sealer.GasPrice)
+		}
+
+	case "miner":
+		s.backend.Miner().SetGasCeil(config.Sealer.GasCeil)
+		s.backend.Miner().SetRecommitInterval(config.Sealer.Recommit)
+
+	case "jsonrpc":
+		s.backend.APIBackend.SetRPCGasCap(config.JsonRPC.GasCap)
+
+	case "gpo":
+		s.backend.APIBackend.SetGasPriceOracle(gasprice.Config{
+			Blocks:      int(config.Gpo.Blocks),
+			Percentile:  int(config.Gpo.Percentile),
+			MaxPrice:    config.Gpo.MaxPrice,
+			IgnorePrice: config.Gpo.IgnorePrice,
+		})
+	}
+
+	return nil
+}
+
+// diffConfig returns the fields whose value differs between the old and the
+// new configuration, named after their path in the configuration file. The
+// values of old are settable.
+func diffConfig(old, new *Config) []configChange {
+	var changes []configChange
+
+	diffStruct("", reflect.ValueOf(old).Elem(), reflect.ValueOf(new).Elem(), &changes)
+
+	return changes
+}
+
+func diffStruct(prefix string, old, new reflect.Value, changes *[]configChange) {
+	typ := old.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() || strings.HasSuffix(field.Name, "Raw") {
+			continue
+		}
+
+		// Fields parsed from a raw string are named after it
+		tag := field.Tag.Get("hcl")
+		if raw, ok := typ.FieldByName(field.Name + "Raw"); ok {
+			tag = raw.Tag.Get("hcl")
+		}
+
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		oldField, newField := old.Field(i), new.Field(i)
+
+		// Walk down the config blocks
+		switch {
+		case field.Type.Kind() == reflect.Struct:
+			diffStruct(name, oldField, newField, changes)
+			continue
+
+		case field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct && field.Type != reflect.TypeOf(&big.Int{}):
+			if !oldField.IsNil() && !newField.IsNil() {
+				diffStruct(name, oldField.Elem(), newField.Elem(), changes)
+				continue
+			}
+		}
+
+		if !configValueEqual(oldField, newField) {
+			*changes = append(*changes, configChange{name: name, old: oldField, new: newField})
+		}
+	}
+}
+
+func configValueEqual(a, b reflect.Value) bool {
+	if x, ok := a.Interface().(*big.Int); ok {
+		y := b.Interface().(*big.Int)
+		if x == nil || y == nil {
+			return x == y
+		}
+
+		return x.Cmp(y) == 0
+	}
+
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
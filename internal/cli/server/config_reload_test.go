@@ -0,0 +1,83 @@
+package server
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigDiff(t *testing.T) {
+	t.Parallel()
+
+	c0, c1 := DefaultConfig(), DefaultConfig()
+	assert.Empty(t, diffConfig(c0, c1))
+
+	c1.Verbosity = 5
+	c1.TxPool.PriceLimit = 2
+	c1.Sealer.GasPrice = big.NewInt(2)
+	c1.Sealer.Recommit = time.Minute
+	c1.JsonRPC.HttpTimeout.ReadTimeout = time.Minute
+	c1.P2P.Discovery.StaticNodes = []string{"enode://a"}
+
+	var names []string
+	for _, change := range diffConfig(c0, c1) {
+		names = append(names, change.name)
+	}
+
+	assert.ElementsMatch(t, []string{
+		"verbosity",
+		"txpool.pricelimit",
+		"miner.gasprice",
+		"miner.recommit",
+		"jsonrpc.timeouts.read",
+		"p2p.discovery.static-nodes",
+	}, names)
+
+	// the same big.Int values in different pointers are equal
+	c1 = DefaultConfig()
+	c1.Gpo.MaxPrice = new(big.Int).Set(c0.Gpo.MaxPrice)
+	assert.Empty(t, diffConfig(c0, c1))
+}
+
+func TestServer_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig()
+	config.Developer.Enabled = true
+	config.Developer.Period = 2
+
+	server, err := CreateMockServer(config)
+	require.NoError(t, err)
+
+	defer CloseMockServer(server)
+
+	// there's nothing to reload without a config file
+	_, _, err = server.reloadConfig()
+	require.ErrorIs(t, err, errNoConfigReader)
+
+	server.readConfig = func() (*Config, error) {
+		config := DefaultConfig()
+		config.Developer.Enabled = true
+		config.Developer.Period = 4
+		config.TxPool.PriceLimit = 5
+		config.JsonRPC.GasCap = 1000
+		config.Sealer.GasCeil = 10_000_000
+
+		return config, nil
+	}
+
+	applied, ignored, err := server.reloadConfig()
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"txpool.pricelimit", "jsonrpc.gascap", "miner.gaslimit"}, applied)
+	assert.Contains(t, ignored, "developer.period")
+	assert.Contains(t, ignored, "datadir")
+
+	assert.Equal(t, uint64(5), server.backend.TxPool().GasPrice().Uint64())
+	assert.Equal(t, uint64(1000), server.backend.APIBackend.RPCGasCap())
+	assert.Equal(t, uint64(10_000_000), server.config.Sealer.GasCeil)
+	assert.Equal(t, uint64(2), server.config.Developer.Period)
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -133,3 +135,83 @@ func TestMakePasswordListFromFile(t *testing.T) {
 		assert.Equal(t, []string{"test1", "test2"}, result)
 	})
 }
+
+func TestConfigHeimdallEndpoints(t *testing.T) {
+	t.Parallel()
+
+	// http urls and grpc addresses can be mixed in a single list of endpoints
+	path := filepath.Join(t.TempDir(), "config.toml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+[heimdall]
+  url = ["http://heimdall-0:1317", "http://heimdall-1:1317"]
+  grpc-address = ["heimdall-2:3132"]
+`), 0600))
+
+	config, err := readConfigFile(path)
+	assert.NoError(t, err)
+
+	c := DefaultConfig()
+	assert.NoError(t, c.Merge(config))
+	assert.NoError(t, c.loadChain())
+
+	eth, err := c.buildEth(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"http://heimdall-0:1317", "http://heimdall-1:1317"}, eth.HeimdallURL)
+	assert.Equal(t, []string{"heimdall-2:3132"}, eth.HeimdallgRPCAddress)
+}
+
+func TestConfigHeimdallEndpointsLegacy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		toml string
+		url  []string
+		grpc []string
+	}{
+		{
+			name: "comma separated strings",
+			toml: `
+[heimdall]
+  url = "http://heimdall-0:1317, http://heimdall-1:1317"
+  grpc-address = ""
+`,
+			url: []string{"http://heimdall-0:1317", "http://heimdall-1:1317"},
+		},
+		{
+			// the grpc address takes precedence over the default url
+			name: "grpc address only",
+			toml: `
+[heimdall]
+  grpc-address = "heimdall-2:3132"
+`,
+			grpc: []string{"heimdall-2:3132"},
+		},
+		{
+			name: "no endpoint",
+			toml: `
+[heimdall]
+  "bor.without" = false
+`,
+			url: []string{defaultHeimdallURL},
+		},
+	}
+
+	for _, test := range tests {
+		path := filepath.Join(t.TempDir(), "config.toml")
+		assert.NoError(t, os.WriteFile(path, []byte(test.toml), 0600))
+
+		config, err := readConfigFile(path)
+		assert.NoError(t, err, test.name)
+
+		c := DefaultConfig()
+		assert.NoError(t, c.Merge(config), test.name)
+		assert.NoError(t, c.loadChain(), test.name)
+
+		eth, err := c.buildEth(nil, nil)
+		assert.NoError(t, err, test.name)
+		// empty lists are compared as nil ones
+		assert.Equal(t, test.url, append([]string(nil), eth.HeimdallURL...), test.name)
+		assert.Equal(t, test.grpc, append([]string(nil), eth.HeimdallgRPCAddress...), test.name)
+	}
+}
@@ -137,10 +137,10 @@ func (c *Command) Flags() *flagset.Flagset {
 	})
 
 	// heimdall
-	f.StringFlag(&flagset.StringFlag{
+	f.SliceStringFlag(&flagset.SliceStringFlag{
 		Name:    "bor.heimdall",
-		Usage:   "URL of Heimdall service",
-		Value:   &c.cliConfig.Heimdall.URL,
+		Usage:   "Comma separated URLs of Heimdall service, more than one endpoint enables failover (" + defaultHeimdallURL + " without any gRPC address)",
+		Value:   (*[]string)(&c.cliConfig.Heimdall.URL),
 		Default: c.cliConfig.Heimdall.URL,
 	})
 	f.BoolFlag(&flagset.BoolFlag{
@@ -155,12 +155,18 @@ func (c *Command) Flags() *flagset.Flagset {
 		Value:   &c.cliConfig.DevFakeAuthor,
 		Default: c.cliConfig.DevFakeAuthor,
 	})
-	f.StringFlag(&flagset.StringFlag{
+	f.SliceStringFlag(&flagset.SliceStringFlag{
 		Name:    "bor.heimdallgRPC",
-		Usage:   "Address of Heimdall gRPC service",
-		Value:   &c.cliConfig.Heimdall.GRPCAddress,
+		Usage:   "Comma separated addresses of Heimdall gRPC service, failed over to along with the URLs",
+		Value:   (*[]string)(&c.cliConfig.Heimdall.GRPCAddress),
 		Default: c.cliConfig.Heimdall.GRPCAddress,
 	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "bor.heimdallcrosscheckspans",
+		Usage:   "Verify every span against a second Heimdall endpoint before committing it",
+		Value:   &c.cliConfig.Heimdall.CrossCheckSpans,
+		Default: c.cliConfig.Heimdall.CrossCheckSpans,
+	})
 	f.BoolFlag(&flagset.BoolFlag{
 		Name:    "bor.runheimdall",
 		Usage:   "Run Heimdall service as a child process",
@@ -179,6 +185,24 @@ func (c *Command) Flags() *flagset.Flagset {
 		Value:   &c.cliConfig.Heimdall.UseHeimdallApp,
 		Default: c.cliConfig.Heimdall.UseHeimdallApp,
 	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "bor.heimdallcache",
+		Usage:   "Cache Heimdall spans, state-sync events and checkpoints in the node database",
+		Value:   &c.cliConfig.Heimdall.Cache,
+		Default: c.cliConfig.Heimdall.Cache,
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "bor.heimdallrecord",
+		Usage:   "Record every Heimdall response to the given JSONL file",
+		Value:   &c.cliConfig.Heimdall.Record,
+		Default: c.cliConfig.Heimdall.Record,
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "bor.heimdallreplay",
+		Usage:   "Serve Heimdall responses from a JSONL file recorded with 'bor.heimdallrecord', without network access",
+		Value:   &c.cliConfig.Heimdall.Replay,
+		Default: c.cliConfig.Heimdall.Replay,
+	})
 
 	// txpool options
 	f.SliceStringFlag(&flagset.SliceStringFlag{
@@ -258,6 +282,34 @@ func (c *Command) Flags() *flagset.Flagset {
 		Default: c.cliConfig.TxPool.LifeTime,
 		Group:   "Transaction Pool",
 	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "txpool.snapshot",
+		Usage:   "Disk snapshot of the pending and queued transactions of all accounts to survive node restarts (disabled if empty)",
+		Value:   &c.cliConfig.TxPool.Snapshot,
+		Default: c.cliConfig.TxPool.Snapshot,
+		Group:   "Transaction Pool",
+	})
+	f.DurationFlag(&flagset.DurationFlag{
+		Name:    "txpool.resnapshot",
+		Usage:   "Time interval to regenerate the transaction pool snapshot",
+		Value:   &c.cliConfig.TxPool.Resnapshot,
+		Default: c.cliConfig.TxPool.Resnapshot,
+		Group:   "Transaction Pool",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "txpool.snapshotaccountslots",
+		Usage:   "Maximum number of transactions per account in the transaction pool snapshot",
+		Value:   &c.cliConfig.TxPool.SnapshotAccountSlots,
+		Default: c.cliConfig.TxPool.SnapshotAccountSlots,
+		Group:   "Transaction Pool",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "txpool.snapshotglobalslots",
+		Usage:   "Maximum number of transactions for all accounts in the transaction pool snapshot",
+		Value:   &c.cliConfig.TxPool.SnapshotGlobalSlots,
+		Default: c.cliConfig.TxPool.SnapshotGlobalSlots,
+		Group:   "Transaction Pool",
+	})
 
 	// sealer options
 	f.BoolFlag(&flagset.BoolFlag{
@@ -309,6 +361,27 @@ func (c *Command) Flags() *flagset.Flagset {
 		Default: c.cliConfig.Sealer.CommitInterruptFlag,
 		Group:   "Sealer",
 	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "miner.parallel",
+		Usage:   "Execute transactions in parallel with block-stm when building blocks",
+		Value:   &c.cliConfig.Sealer.ParallelBuild,
+		Default: c.cliConfig.Sealer.ParallelBuild,
+		Group:   "Sealer",
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "miner.remotesigner",
+		Usage:   "HTTP or IPC endpoint of an external signer to seal blocks with, instead of the local keystore",
+		Value:   &c.cliConfig.Sealer.RemoteSigner,
+		Default: c.cliConfig.Sealer.RemoteSigner,
+		Group:   "Sealer",
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "miner.remotesignertype",
+		Usage:   "Type of the external signer (clef or web3signer)",
+		Value:   &c.cliConfig.Sealer.RemoteSignerType,
+		Default: c.cliConfig.Sealer.RemoteSignerType,
+		Group:   "Sealer",
+	})
 
 	// ethstats
 	f.StringFlag(&flagset.StringFlag{
@@ -471,6 +544,76 @@ func (c *Command) Flags() *flagset.Flagset {
 		Default: c.cliConfig.JsonRPC.AllowUnprotectedTxs,
 		Group:   "JsonRPC",
 	})
+	f.Float64Flag(&flagset.Float64Flag{
+		Name:    "rpc.quota.budget",
+		Usage:   "Cost each HTTP and WS client can spend per quota period, the cost of the methods being set in the config file (0 = no quota)",
+		Value:   &c.cliConfig.JsonRPC.Quota.Budget,
+		Default: c.cliConfig.JsonRPC.Quota.Budget,
+		Group:   "JsonRPC",
+	})
+	f.DurationFlag(&flagset.DurationFlag{
+		Name:    "rpc.quota.period",
+		Usage:   "Time over which the quota budget of the clients is refilled",
+		Value:   &c.cliConfig.JsonRPC.Quota.Period,
+		Default: c.cliConfig.JsonRPC.Quota.Period,
+		Group:   "JsonRPC",
+	})
+	f.Float64Flag(&flagset.Float64Flag{
+		Name:    "rpc.quota.defaultcost",
+		Usage:   "Quota cost of the methods without a specific one",
+		Value:   &c.cliConfig.JsonRPC.Quota.DefaultCost,
+		Default: c.cliConfig.JsonRPC.Quota.DefaultCost,
+		Group:   "JsonRPC",
+	})
+	f.Float64Flag(&flagset.Float64Flag{
+		Name:    "rpc.quota.logsblockcost",
+		Usage:   "Additional quota cost of eth_getLogs per block of the queried range",
+		Value:   &c.cliConfig.JsonRPC.Quota.LogsBlockCost,
+		Default: c.cliConfig.JsonRPC.Quota.LogsBlockCost,
+		Group:   "JsonRPC",
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "rpc.quota.header",
+		Usage:   "HTTP header with the API key identifying the quota clients, among the keys set in the config file, by IP otherwise",
+		Value:   &c.cliConfig.JsonRPC.Quota.Header,
+		Default: c.cliConfig.JsonRPC.Quota.Header,
+		Group:   "JsonRPC",
+	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "rpc.health",
+		Usage:   "Serve the /health and /ready endpoints on the HTTP-RPC server",
+		Value:   &c.cliConfig.JsonRPC.Health.Enabled,
+		Default: c.cliConfig.JsonRPC.Health.Enabled,
+		Group:   "JsonRPC",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "rpc.health.maxsyncdistance",
+		Usage:   "Number of blocks the node can be behind its peers while ready",
+		Value:   &c.cliConfig.JsonRPC.Health.MaxSyncDistance,
+		Default: c.cliConfig.JsonRPC.Health.MaxSyncDistance,
+		Group:   "JsonRPC",
+	})
+	f.DurationFlag(&flagset.DurationFlag{
+		Name:    "rpc.health.maxblockage",
+		Usage:   "Age of the last block beyond which the node isn't ready",
+		Value:   &c.cliConfig.JsonRPC.Health.MaxBlockAge,
+		Default: c.cliConfig.JsonRPC.Health.MaxBlockAge,
+		Group:   "JsonRPC",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "rpc.health.minpeers",
+		Usage:   "Number of peers below which the node isn't healthy",
+		Value:   &c.cliConfig.JsonRPC.Health.MinPeers,
+		Default: c.cliConfig.JsonRPC.Health.MinPeers,
+		Group:   "JsonRPC",
+	})
+	f.DurationFlag(&flagset.DurationFlag{
+		Name:    "rpc.health.heimdalltimeout",
+		Usage:   "Time a request can wait for heimdall before it's deemed unreachable, making the node not ready",
+		Value:   &c.cliConfig.JsonRPC.Health.HeimdallTimeout,
+		Default: c.cliConfig.JsonRPC.Health.HeimdallTimeout,
+		Group:   "JsonRPC",
+	})
 	f.BoolFlag(&flagset.BoolFlag{
 		Name:    "ipcdisable",
 		Usage:   "Disable the IPC-RPC server",
@@ -812,7 +955,7 @@ func (c *Command) Flags() *flagset.Flagset {
 	})
 	f.StringFlag(&flagset.StringFlag{
 		Name:    "metrics.opencollector-endpoint",
-		Usage:   "OpenCollector Endpoint (host:port)",
+		Usage:   "OpenCollector Endpoint (host:port) to export traces to over OTLP, even with metrics disabled",
 		Value:   &c.cliConfig.Telemetry.OpenCollectorEndpoint,
 		Default: c.cliConfig.Telemetry.OpenCollectorEndpoint,
 		Group:   "Telemetry",
@@ -914,10 +1057,16 @@ func (c *Command) Flags() *flagset.Flagset {
 	})
 	f.IntFlag(&flagset.IntFlag{
 		Name:    "parallelevm.procs",
-		Usage:   "Number of speculative processes (cores) in Block STM",
+		Usage:   "Maximum number of speculative processes (cores) in Block STM, sized for each block",
 		Value:   &c.cliConfig.ParallelEVM.SpeculativeProcesses,
 		Default: c.cliConfig.ParallelEVM.SpeculativeProcesses,
 	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "parallelevm.verifydeps",
+		Usage:   "Compare the transaction dependencies declared in block headers with their execution in Block STM",
+		Value:   &c.cliConfig.ParallelEVM.VerifyDependencies,
+		Default: c.cliConfig.ParallelEVM.VerifyDependencies,
+	})
 	f.Uint64Flag(&flagset.Uint64Flag{
 		Name:    "dev.gaslimit",
 		Usage:   "Initial block gas limit",
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallstatus"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// validatorCheckTimeout bounds the lookup of the validators of the current span
+const validatorCheckTimeout = 5 * time.Second
+
+// HealthCheck is the outcome of a single check of the health of the node.
+type HealthCheck struct {
+	OK      bool        `json:"ok"`
+	Value   interface{} `json:"value"`
+	Limit   interface{} `json:"limit,omitempty"`
+	Message string      `json:"message,omitempty"`
+}
+
+// HealthReport is the health of the node, served by the /health and /ready
+// endpoints. The node is healthy if it's connected to enough peers, and ready
+// once heimdall is also reachable and it's in sync with its peers with a recent
+// head block. The validator check only reports whether the signer of the node is
+// in the current span, it never fails.
+type HealthReport struct {
+	Healthy bool                   `json:"healthy"`
+	Ready   bool                   `json:"ready"`
+	Checks  map[string]HealthCheck `json:"checks"`
+}
+
+// healthStatus is the state of the node the health checks are evaluated on.
+type healthStatus struct {
+	headNumber   uint64
+	headTime     time.Time
+	highestBlock uint64
+	peers        int
+
+	heimdall *heimdallstatus.Status // nil without heimdall
+
+	signer    common.Address // zero if the node doesn't seal
+	inSpan    bool
+	inSpanErr error
+}
+
+// evaluateHealth checks the status of the node against the thresholds.
+func evaluateHealth(config *HealthConfig, status *healthStatus, now time.Time) *HealthReport {
+	report := &HealthReport{Checks: make(map[string]HealthCheck)}
+
+	var distance uint64
+	if status.highestBlock > status.headNumber {
+		distance = status.highestBlock - status.headNumber
+	}
+
+	report.Checks["syncDistance"] = HealthCheck{
+		OK:    distance <= config.MaxSyncDistance,
+		Value: distance,
+		Limit: config.MaxSyncDistance,
+	}
+
+	age := now.Sub(status.headTime).Truncate(time.Second)
+	if age < 0 {
+		age = 0
+	}
+
+	report.Checks["blockAge"] = HealthCheck{
+		OK:    age <= config.MaxBlockAge,
+		Value: age.String(),
+		Limit: config.MaxBlockAge.String(),
+	}
+
+	report.Checks["peers"] = HealthCheck{
+		OK:    uint64(status.peers) >= config.MinPeers,
+		Value: status.peers,
+		Limit: config.MinPeers,
+	}
+
+	if status.heimdall != nil {
+		check := HealthCheck{
+			OK:    status.heimdall.Reachable(config.HeimdallTimeout, now),
+			Value: "reachable",
+		}
+
+		if !check.OK {
+			check.Value = "unreachable"
+			check.Message = status.heimdall.LastError
+
+			if pending := status.heimdall.PendingSince; !pending.IsZero() && now.Sub(pending) > config.HeimdallTimeout {
+				check.Message = "request pending for " + now.Sub(pending).Truncate(time.Second).String()
+			}
+		}
+
+		report.Checks["heimdall"] = check
+	}
+
+	if status.signer != (common.Address{}) {
+		check := HealthCheck{OK: true, Value: status.inSpan}
+		if status.inSpanErr != nil {
+			check.Value = nil
+			check.Message = status.inSpanErr.Error()
+		}
+
+		report.Checks["validatorInSpan"] = check
+	}
+
+	report.Healthy = report.Checks["peers"].OK
+	report.Ready = report.Healthy && report.Checks["syncDistance"].OK && report.Checks["blockAge"].OK
+
+	if check, ok := report.Checks["heimdall"]; ok {
+		report.Ready = report.Ready && check.OK
+	}
+
+	return report
+}
+
+// healthChecker serves the health of the node over http.
+type healthChecker struct {
+	srv    *Server
+	config *HealthConfig
+
+	lock       sync.Mutex
+	spanHead   common.Hash // head the validators of the span were looked up at
+	spanResult bool
+	spanErr    error
+}
+
+func newHealthChecker(srv *Server, config *HealthConfig) *healthChecker {
+	return &healthChecker{srv: srv, config: config}
+}
+
+// status gathers the state of the node the health checks are evaluated on.
+func (h *healthChecker) status() *healthStatus {
+	backend := h.srv.backend
+	head := backend.BlockChain().CurrentHeader()
+
+	status := &healthStatus{
+		headNumber:   head.Number.Uint64(),
+		headTime:     time.Unix(int64(head.Time), 0),
+		highestBlock: backend.APIBackend.SyncProgress().HighestBlock,
+	}
+
+	if h.srv.node != nil && h.srv.node.Server() != nil {
+		status.peers = h.srv.node.Server().PeerCount()
+	}
+
+	engine, ok := backend.Engine().(*bor.Bor)
+	if !ok {
+		return status
+	}
+
+	// look through wrapping clients such as the heimdall cache
+	client := engine.HeimdallClient
+	for client != nil {
+		if tracker, ok := client.(*heimdallstatus.HeimdallStatusClient); ok {
+			heimdall := tracker.Status()
+			status.heimdall = &heimdall
+
+			break
+		}
+
+		wrapper, ok := client.(interface{ Unwrap() bor.IHeimdallClient })
+		if !ok {
+			break
+		}
+
+		client = wrapper.Unwrap()
+	}
+
+	if status.signer = engine.AuthorizedSigner(); status.signer != (common.Address{}) {
+		status.inSpan, status.inSpanErr = h.inCurrentSpan(engine, head.Hash(), status.headNumber, status.signer)
+	}
+
+	return status
+}
+
+// inCurrentSpan reports whether the signer is a validator of the span of the
+// next block, looking the validators up once per head.
+func (h *healthChecker) inCurrentSpan(engine *bor.Bor, head common.Hash, number uint64, signer common.Address) (bool, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.spanHead == head {
+		return h.spanResult, h.spanErr
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), validatorCheckTimeout)
+	defer cancel()
+
+	validators, err := engine.GetCurrentValidators(ctx, head, number+1)
+
+	h.spanHead, h.spanResult, h.spanErr = head, false, err
+	for _, validator := range validators {
+		if validator.Address == signer {
+			h.spanResult = true
+			break
+		}
+	}
+
+	return h.spanResult, h.spanErr
+}
+
+// handler returns the http handler of an endpoint, answering with the health
+// report and a 503 status code if the given condition of the report fails.
+func (h *healthChecker) handler(pass func(*HealthReport) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := evaluateHealth(h.config, h.status(), time.Now())
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if !pass(report) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			log.Debug("Failed to write health report", "err", err)
+		}
+	})
+}
+
+// healthHandler serves /health, failing if the node isn't healthy.
+func (h *healthChecker) healthHandler() http.Handler {
+	return h.handler(func(report *HealthReport) bool { return report.Healthy })
+}
+
+// readyHandler serves /ready, failing if the node isn't ready.
+func (h *healthChecker) readyHandler() http.Handler {
+	return h.handler(func(report *HealthReport) bool { return report.Ready })
+}
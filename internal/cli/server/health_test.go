@@ -0,0 +1,119 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallstatus"
+)
+
+func TestEvaluateHealth(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	config := &HealthConfig{
+		MaxSyncDistance: 10,
+		MaxBlockAge:     time.Minute,
+		MinPeers:        2,
+		HeimdallTimeout: 30 * time.Second,
+	}
+
+	synced := func() *healthStatus {
+		return &healthStatus{
+			headNumber:   100,
+			headTime:     now.Add(-2 * time.Second),
+			highestBlock: 95,
+			peers:        5,
+			heimdall:     &heimdallstatus.Status{LastSuccess: now.Add(-time.Second)},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		update  func(*healthStatus)
+		healthy bool
+		ready   bool
+		failing string
+	}{
+		{"synced", func(*healthStatus) {}, true, true, ""},
+		{"behind peers", func(s *healthStatus) { s.highestBlock = 111 }, true, false, "syncDistance"},
+		{"old head", func(s *healthStatus) { s.headTime = now.Add(-2 * time.Minute) }, true, false, "blockAge"},
+		{"few peers", func(s *healthStatus) { s.peers = 1 }, false, false, "peers"},
+		{"heimdall failing", func(s *healthStatus) {
+			s.heimdall.LastFailure = now
+			s.heimdall.LastError = "connection refused"
+		}, true, false, "heimdall"},
+		{"heimdall pending", func(s *healthStatus) { s.heimdall.PendingSince = now.Add(-time.Minute) }, true, false, "heimdall"},
+		{"no heimdall", func(s *healthStatus) { s.heimdall = nil }, true, true, ""},
+	}
+
+	for _, c := range cases {
+		status := synced()
+		c.update(status)
+
+		report := evaluateHealth(config, status, now)
+		require.Equal(t, c.healthy, report.Healthy, c.name)
+		require.Equal(t, c.ready, report.Ready, c.name)
+
+		for name, check := range report.Checks {
+			require.Equal(t, name != c.failing, check.OK, "%s: check %s", c.name, name)
+		}
+	}
+
+	// The validator check is only reported for the nodes sealing blocks, and never fails
+	status := synced()
+	report := evaluateHealth(config, status, now)
+	require.NotContains(t, report.Checks, "validatorInSpan")
+
+	status.signer = common.HexToAddress("0x1")
+	status.inSpanErr = errors.New("no span")
+	report = evaluateHealth(config, status, now)
+	require.True(t, report.Checks["validatorInSpan"].OK)
+	require.Equal(t, "no span", report.Checks["validatorInSpan"].Message)
+	require.True(t, report.Ready)
+}
+
+// Not parallel, a second developer node sealing concurrently slows down the one
+// of TestServer_DeveloperMode past its block time.
+func TestHealthEndpoints(t *testing.T) {
+	config := DefaultConfig()
+	config.Developer.Enabled = true
+	config.Developer.Period = 0 // only seal on transactions, so the head is the genesis
+	config.JsonRPC.Health.Enabled = true
+	config.JsonRPC.Health.MinPeers = 0
+
+	server, err := CreateMockServer(config)
+	require.NoError(t, err)
+
+	defer CloseMockServer(server)
+
+	checker := newHealthChecker(server, config.JsonRPC.Health)
+
+	get := func(handler http.Handler) (int, *HealthReport) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		var report HealthReport
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+
+		return rec.Code, &report
+	}
+
+	// The genesis block of the developer chain is older than the max block age
+	code, report := get(checker.healthHandler())
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, report.Healthy)
+
+	code, report = get(checker.readyHandler())
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.False(t, report.Ready)
+	require.False(t, report.Checks["blockAge"].OK)
+	require.True(t, report.Checks["syncDistance"].OK)
+}
@@ -31,6 +31,9 @@ type BorClient interface {
 	ChainWatch(ctx context.Context, in *ChainWatchRequest, opts ...grpc.CallOption) (Bor_ChainWatchClient, error)
 	DebugPprof(ctx context.Context, in *DebugPprofRequest, opts ...grpc.CallOption) (Bor_DebugPprofClient, error)
 	DebugBlock(ctx context.Context, in *DebugBlockRequest, opts ...grpc.CallOption) (Bor_DebugBlockClient, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	WhitelistList(ctx context.Context, in *WhitelistListRequest, opts ...grpc.CallOption) (*WhitelistListResponse, error)
+	WhitelistPurge(ctx context.Context, in *WhitelistPurgeRequest, opts ...grpc.CallOption) (*WhitelistPurgeResponse, error)
 }
 
 type borClient struct {
@@ -191,6 +194,33 @@ func (x *borDebugBlockClient) Recv() (*DebugFileResponse, error) {
 	return m, nil
 }
 
+func (c *borClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) WhitelistList(ctx context.Context, in *WhitelistListRequest, opts ...grpc.CallOption) (*WhitelistListResponse, error) {
+	out := new(WhitelistListResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/WhitelistList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) WhitelistPurge(ctx context.Context, in *WhitelistPurgeRequest, opts ...grpc.CallOption) (*WhitelistPurgeResponse, error) {
+	out := new(WhitelistPurgeResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/WhitelistPurge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BorServer is the server API for Bor service.
 // All implementations must embed UnimplementedBorServer
 // for forward compatibility
@@ -204,6 +234,9 @@ type BorServer interface {
 	ChainWatch(*ChainWatchRequest, Bor_ChainWatchServer) error
 	DebugPprof(*DebugPprofRequest, Bor_DebugPprofServer) error
 	DebugBlock(*DebugBlockRequest, Bor_DebugBlockServer) error
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	WhitelistList(context.Context, *WhitelistListRequest) (*WhitelistListResponse, error)
+	WhitelistPurge(context.Context, *WhitelistPurgeRequest) (*WhitelistPurgeResponse, error)
 	mustEmbedUnimplementedBorServer()
 }
 
@@ -238,6 +271,15 @@ func (UnimplementedBorServer) DebugPprof(*DebugPprofRequest, Bor_DebugPprofServe
 func (UnimplementedBorServer) DebugBlock(*DebugBlockRequest, Bor_DebugBlockServer) error {
 	return status.Errorf(codes.Unimplemented, "method DebugBlock not implemented")
 }
+func (UnimplementedBorServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedBorServer) WhitelistList(context.Context, *WhitelistListRequest) (*WhitelistListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistList not implemented")
+}
+func (UnimplementedBorServer) WhitelistPurge(context.Context, *WhitelistPurgeRequest) (*WhitelistPurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistPurge not implemented")
+}
 func (UnimplementedBorServer) mustEmbedUnimplementedBorServer() {}
 
 // UnsafeBorServer may be embedded to opt out of forward compatibility for this service.
@@ -422,6 +464,60 @@ func (x *borDebugBlockServer) Send(m *DebugFileResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Bor_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_WhitelistList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhitelistListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).WhitelistList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/WhitelistList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).WhitelistList(ctx, req.(*WhitelistListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_WhitelistPurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhitelistPurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).WhitelistPurge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/WhitelistPurge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).WhitelistPurge(ctx, req.(*WhitelistPurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Bor_ServiceDesc is the grpc.ServiceDesc for Bor service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -453,6 +549,18 @@ var Bor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Bor_Status_Handler,
 		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _Bor_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "WhitelistList",
+			Handler:    _Bor_WhitelistList_Handler,
+		},
+		{
+			MethodName: "WhitelistPurge",
+			Handler:    _Bor_WhitelistPurge_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
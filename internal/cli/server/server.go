@@ -10,6 +10,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mattn/go-colorable"
@@ -26,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/consensus/beacon" //nolint:typecheck
 	"github.com/ethereum/go-ethereum/consensus/bor"    //nolint:typecheck
+	"github.com/ethereum/go-ethereum/consensus/bor/remotesigner"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/eth/tracers"
@@ -38,6 +40,7 @@ import (
 	"github.com/ethereum/go-ethereum/metrics/influxdb"
 	"github.com/ethereum/go-ethereum/metrics/prometheus"
 	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	// Force-load the tracer engines to trigger registration
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
@@ -54,6 +57,10 @@ type Server struct {
 
 	// tracerAPI to trace block executions
 	tracerAPI *tracers.API
+
+	// readConfig re-reads the config when it's reloaded
+	readConfig func() (*Config, error)
+	reloadLock sync.Mutex
 }
 
 type serverOption func(srv *Server, config *Config) error
@@ -241,6 +248,16 @@ func NewServer(config *Config, opts ...serverOption) (*Server, error) {
 		}
 	}
 
+	// authorize the bor consensus to seal through the external signer, if any, which
+	// takes precedence over the local keystore
+	if config.Sealer.Enabled && config.Sealer.RemoteSigner != "" {
+		if err := srv.authorizeRemoteSigner(config.Sealer); err != nil {
+			return nil, err
+		}
+
+		authorized = true
+	}
+
 	// set the auth status in backend
 	srv.backend.SetAuthorized(authorized)
 
@@ -273,6 +290,39 @@ func NewServer(config *Config, opts ...serverOption) (*Server, error) {
 		return nil, err
 	}
 
+	if err := srv.setupTracing(config.Telemetry, config.Identity); err != nil {
+		return nil, err
+	}
+
+	// enable the quotas of the http and websocket clients
+	if quota := config.JsonRPC.Quota; quota.Budget > 0 {
+		chain := srv.backend.BlockChain()
+
+		stack.SetRPCQuota(rpc.QuotaConfig{
+			Budget:        quota.Budget,
+			Period:        quota.Period,
+			DefaultCost:   quota.DefaultCost,
+			Costs:         quota.Costs,
+			LogsBlockCost: quota.LogsBlockCost,
+			APIKeyHeader:  quota.Header,
+			APIKeys:       quota.Keys,
+			HeadNumber: func() uint64 {
+				return chain.CurrentBlock().NumberU64()
+			},
+		})
+	}
+
+	// serve the health and readiness endpoints next to the http rpc
+	if health := config.JsonRPC.Health; health.Enabled {
+		if !config.JsonRPC.Http.Enabled {
+			log.Warn("Health endpoints require the HTTP-RPC server, which is disabled")
+		}
+
+		checker := newHealthChecker(srv, health)
+		stack.RegisterHandler("Health", "/health", checker.healthHandler())
+		stack.RegisterHandler("Readiness", "/ready", checker.readyHandler())
+	}
+
 	// Set the node instance
 	srv.node = stack
 
@@ -301,6 +351,31 @@ func (s *Server) Stop() {
 	}
 }
 
+// authorizeRemoteSigner authorizes the bor consensus to seal blocks through the
+// configured external signer, so that the validator key stays off the node
+func (s *Server) authorizeRemoteSigner(config *SealerConfig) error {
+	borEngine, ok := s.backend.Engine().(*bor.Bor)
+	if !ok {
+		return fmt.Errorf("remote signer is only supported by the bor consensus")
+	}
+
+	eb, err := s.backend.Etherbase()
+	if err != nil {
+		return fmt.Errorf("etherbase missing: %v", err)
+	}
+
+	signFn, err := remotesigner.New(config.RemoteSignerType, config.RemoteSigner)
+	if err != nil {
+		return err
+	}
+
+	borEngine.AuthorizeRemote(eb, signFn)
+
+	log.Info("Sealing through remote signer", "type", config.RemoteSignerType, "signer", eb)
+
+	return nil
+}
+
 func (s *Server) setupMetrics(config *TelemetryConfig, serviceName string) error {
 	// Check the global metrics if they're matching with the provided config
 	if metrics.Enabled != config.Enabled || metrics.EnabledExpensive != config.Expensive {
@@ -374,48 +449,56 @@ func (s *Server) setupMetrics(config *TelemetryConfig, serviceName string) error
 
 	}
 
-	if config.OpenCollectorEndpoint != "" {
-		// setup open collector tracer
-		ctx := context.Background()
+	return nil
+}
 
-		res, err := resource.New(ctx,
-			resource.WithAttributes(
-				// the service name used to display traces in backends
-				semconv.ServiceNameKey.String(serviceName),
-			),
-		)
-		if err != nil {
-			return fmt.Errorf("failed to create open telemetry resource for service: %v", err)
-		}
+// setupTracing exports the spans of the RPC calls, block imports and sealing to
+// the open collector endpoint, if any. It's independent of the metrics being enabled.
+func (s *Server) setupTracing(config *TelemetryConfig, serviceName string) error {
+	if config.OpenCollectorEndpoint == "" {
+		return nil
+	}
 
-		// Set up a trace exporter
-		traceExporter, err := otlptracegrpc.New(
-			ctx,
-			otlptracegrpc.WithInsecure(),
-			otlptracegrpc.WithEndpoint(config.OpenCollectorEndpoint),
-		)
-		if err != nil {
-			return fmt.Errorf("failed to create open telemetry tracer exporter for service: %v", err)
-		}
+	// setup open collector tracer
+	ctx := context.Background()
 
-		// Register the trace exporter with a TracerProvider, using a batch
-		// span processor to aggregate spans before export.
-		bsp := sdktrace.NewBatchSpanProcessor(traceExporter)
-		tracerProvider := sdktrace.NewTracerProvider(
-			sdktrace.WithSampler(sdktrace.AlwaysSample()),
-			sdktrace.WithResource(res),
-			sdktrace.WithSpanProcessor(bsp),
-		)
-		otel.SetTracerProvider(tracerProvider)
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			// the service name used to display traces in backends
+			semconv.ServiceNameKey.String(serviceName),
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create open telemetry resource for service: %v", err)
+	}
 
-		// set global propagator to tracecontext (the default is no-op).
-		otel.SetTextMapPropagator(propagation.TraceContext{})
+	// Set up a trace exporter
+	traceExporter, err := otlptracegrpc.New(
+		ctx,
+		otlptracegrpc.WithInsecure(),
+		otlptracegrpc.WithEndpoint(config.OpenCollectorEndpoint),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create open telemetry tracer exporter for service: %v", err)
+	}
 
-		// set the tracer
-		s.tracer = tracerProvider
+	// Register the trace exporter with a TracerProvider, using a batch
+	// span processor to aggregate spans before export.
+	bsp := sdktrace.NewBatchSpanProcessor(traceExporter)
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+		sdktrace.WithResource(res),
+		sdktrace.WithSpanProcessor(bsp),
+	)
+	otel.SetTracerProvider(tracerProvider)
 
-		log.Info("Open collector tracing started", "address", config.OpenCollectorEndpoint)
-	}
+	// set global propagator to tracecontext (the default is no-op).
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	// set the tracer
+	s.tracer = tracerProvider
+
+	log.Info("Open collector tracing started", "address", config.OpenCollectorEndpoint)
 
 	return nil
 }
@@ -7,11 +7,14 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	grpc_net_conn "github.com/JekaMas/go-grpc-net-conn"
 
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallfailover"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/tracers"
@@ -168,6 +171,34 @@ func (s *Server) ChainSetHead(ctx context.Context, req *proto.ChainSetHeadReques
 	return &proto.ChainSetHeadResponse{}, nil
 }
 
+func (s *Server) ReloadConfig(ctx context.Context, req *proto.ReloadConfigRequest) (*proto.ReloadConfigResponse, error) {
+	applied, ignored, err := s.reloadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ReloadConfigResponse{Applied: applied, Ignored: ignored}, nil
+}
+
+func (s *Server) WhitelistList(ctx context.Context, req *proto.WhitelistListRequest) (*proto.WhitelistListResponse, error) {
+	resp := &proto.WhitelistListResponse{}
+
+	for number, hash := range s.backend.APIBackend.GetCheckpointWhitelist() {
+		resp.Checkpoints = append(resp.Checkpoints, &proto.Header{Number: number, Hash: hash.String()})
+	}
+
+	sort.Slice(resp.Checkpoints, func(i, j int) bool {
+		return resp.Checkpoints[i].Number < resp.Checkpoints[j].Number
+	})
+
+	return resp, nil
+}
+
+func (s *Server) WhitelistPurge(ctx context.Context, req *proto.WhitelistPurgeRequest) (*proto.WhitelistPurgeResponse, error) {
+	s.backend.APIBackend.PurgeCheckpointWhitelist()
+	return &proto.WhitelistPurgeResponse{}, nil
+}
+
 func (s *Server) Status(ctx context.Context, in *proto.StatusRequest) (*proto.StatusResponse, error) {
 	if s.backend == nil && !in.Wait {
 		return nil, ErrUnavailable
@@ -205,11 +236,54 @@ func (s *Server) Status(ctx context.Context, in *proto.StatusRequest) (*proto.St
 			HighestBlock:  int64(syncProgress.HighestBlock),
 			CurrentBlock:  int64(syncProgress.CurrentBlock),
 		},
-		Forks: gatherForks(s.config.chain.Genesis.Config, s.config.chain.Genesis.Config.Bor),
+		Forks:             gatherForks(s.config.chain.Genesis.Config, s.config.chain.Genesis.Config.Bor),
+		HeimdallEndpoints: s.gatherHeimdallEndpoints(),
 	}
 	return resp, nil
 }
 
+// gatherHeimdallEndpoints reports the health of the heimdall endpoints, if the
+// node fails over between several of them
+func (s *Server) gatherHeimdallEndpoints() []*proto.StatusResponse_HeimdallEndpoint {
+	engine, ok := s.backend.Engine().(*bor.Bor)
+	if !ok {
+		return nil
+	}
+
+	client := engine.HeimdallClient
+
+	// look through wrapping clients such as the heimdall cache
+	for {
+		wrapper, ok := client.(interface{ Unwrap() bor.IHeimdallClient })
+		if !ok {
+			break
+		}
+
+		client = wrapper.Unwrap()
+	}
+
+	failover, ok := client.(*heimdallfailover.FailoverHeimdallClient)
+	if !ok {
+		return nil
+	}
+
+	health := failover.Health()
+	endpoints := make([]*proto.StatusResponse_HeimdallEndpoint, 0, len(health))
+
+	for _, h := range health {
+		endpoints = append(endpoints, &proto.StatusResponse_HeimdallEndpoint{
+			Name:      h.Name,
+			Healthy:   h.Healthy(),
+			Requests:  h.Requests,
+			Errors:    h.Errors,
+			LatencyMs: h.Latency.Milliseconds(),
+			LastError: h.LastError,
+		})
+	}
+
+	return endpoints
+}
+
 func headerToProtoHeader(h *types.Header) *proto.Header {
 	return &proto.Header{
 		Hash:   h.Hash().String(),
@@ -1,11 +1,14 @@
 package server
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
 )
 
@@ -42,3 +45,34 @@ func TestGatherBlocks(t *testing.T) {
 	res := gatherForks(val, val2)
 	assert.Equal(t, res, expect)
 }
+
+func TestServer_Whitelist(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig()
+	config.Developer.Enabled = true
+	config.Developer.Period = 2
+
+	server, err := CreateMockServer(config)
+	require.NoError(t, err)
+
+	defer CloseMockServer(server)
+
+	validator := server.backend.Downloader().ChainValidator
+	validator.ProcessCheckpoint(20, common.HexToHash("0x2"))
+	validator.ProcessCheckpoint(10, common.HexToHash("0x1"))
+
+	resp, err := server.WhitelistList(context.Background(), &proto.WhitelistListRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*proto.Header{
+		{Number: 10, Hash: common.HexToHash("0x1").String()},
+		{Number: 20, Hash: common.HexToHash("0x2").String()},
+	}, resp.Checkpoints)
+
+	_, err = server.WhitelistPurge(context.Background(), &proto.WhitelistPurgeRequest{})
+	require.NoError(t, err)
+
+	resp, err = server.WhitelistList(context.Background(), &proto.WhitelistListRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Checkpoints)
+}
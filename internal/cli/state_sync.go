@@ -0,0 +1,230 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/mitchellh/cli"
+)
+
+// StateSyncCommand is the command to inspect the state-sync events committed by a node
+type StateSyncCommand struct {
+	UI cli.Ui
+
+	rpcURL      string
+	heimdallURL string
+	from        uint64
+	to          uint64
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *StateSyncCommand) MarkDown() string {
+	items := []string{
+		"# State-sync",
+		"The ```state-sync``` command lists the state-sync events committed in a range of blocks and detects skipped ids, " +
+			"as well as events which don't match the ones on Heimdall.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *StateSyncCommand) Help() string {
+	return `Usage: bor state-sync --from <block> [--to <block>]
+
+  List the state-sync events committed in a range of blocks and check them against Heimdall ` + c.Flags().Help()
+}
+
+func (c *StateSyncCommand) Flags() *flagset.Flagset {
+	flags := flagset.NewFlagSet("state-sync")
+
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "rpc",
+		Default: "http://localhost:8545",
+		Usage:   "RPC endpoint of the bor node, with the bor namespace enabled",
+		Value:   &c.rpcURL,
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "heimdall",
+		Default: "http://localhost:1317",
+		Usage:   "URL of Heimdall service to check the events against (no check if empty)",
+		Value:   &c.heimdallURL,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "from",
+		Default: 0,
+		Usage:   "First block to inspect",
+		Value:   &c.from,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "to",
+		Default: 0,
+		Usage:   "Last block to inspect (latest block if 0)",
+		Value:   &c.to,
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (c *StateSyncCommand) Synopsis() string {
+	return "Inspect committed state-sync events"
+}
+
+// Run implements the cli.Command interface
+func (c *StateSyncCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	ctx := context.Background()
+
+	client, err := rpc.DialContext(ctx, c.rpcURL)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("failed to connect to bor rpc: %v", err))
+		return 1
+	}
+	defer client.Close()
+
+	to := c.to
+	if to == 0 {
+		if to, err = ethclient.NewClient(client).BlockNumber(ctx); err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+	}
+
+	if c.from > to {
+		c.UI.Error(fmt.Sprintf("invalid block range %d-%d", c.from, to))
+		return 1
+	}
+
+	var events []*bor.StateSyncEvent
+
+	for start := c.from; start <= to; start += bor.MaxStateSyncBlockRange {
+		end := start + bor.MaxStateSyncBlockRange - 1
+		if end > to {
+			end = to
+		}
+
+		var page []*bor.StateSyncEvent
+		if err := client.CallContext(ctx, &page, "bor_getStateSyncEvents", start, end); err != nil {
+			c.UI.Error(err.Error())
+			return 1
+		}
+
+		events = append(events, page...)
+	}
+
+	c.UI.Output(formatStateSyncEvents(events))
+
+	if len(events) == 0 {
+		return 0
+	}
+
+	var heimdallEvents []*clerk.EventRecordWithTime
+
+	if c.heimdallURL != "" {
+		heimdallClient := heimdall.NewHeimdallClient(c.heimdallURL)
+		defer heimdallClient.Close()
+
+		// the heimdall client retries forever, give up at some point
+		fetchCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		last := events[len(events)-1]
+
+		heimdallEvents, err = heimdallClient.StateSyncEvents(fetchCtx, events[0].ID, last.Time.Unix()+1)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("failed to fetch events from heimdall: %v", err))
+			return 1
+		}
+	}
+
+	issues := checkStateSyncEvents(events, heimdallEvents, c.heimdallURL != "")
+	if len(issues) == 0 {
+		c.UI.Output(fmt.Sprintf("\nNo issues found in state ids %d-%d", events[0].ID, events[len(events)-1].ID))
+		return 0
+	}
+
+	c.UI.Output("\nIssues:\n" + formatList(issues))
+
+	return 1
+}
+
+// checkStateSyncEvents returns the issues found in the ordered list of committed
+// events, that is skipped ids and, if checkHeimdall is set, events which don't
+// match the given heimdall events.
+func checkStateSyncEvents(events []*bor.StateSyncEvent, heimdallEvents []*clerk.EventRecordWithTime, checkHeimdall bool) []string {
+	issues := []string{"ID|Block|Issue"}
+
+	byID := make(map[uint64]*clerk.EventRecordWithTime, len(heimdallEvents))
+	for _, event := range heimdallEvents {
+		byID[event.ID] = event
+	}
+
+	for i, event := range events {
+		if i > 0 && event.ID != events[i-1].ID+1 {
+			for id := events[i-1].ID + 1; id < event.ID; id++ {
+				issues = append(issues, fmt.Sprintf("%d|%s|skipped", id, emptyPlaceHolder))
+			}
+		}
+
+		if !checkHeimdall {
+			continue
+		}
+
+		heimdallEvent, ok := byID[event.ID]
+
+		switch {
+		case !ok:
+			issues = append(issues, fmt.Sprintf("%d|%d|not found on heimdall", event.ID, event.BlockNumber))
+		case heimdallEvent.Contract != event.Contract:
+			issues = append(issues, fmt.Sprintf("%d|%d|contract mismatch, heimdall has %s", event.ID, event.BlockNumber, heimdallEvent.Contract))
+		case !bytes.Equal(heimdallEvent.Data, event.Data):
+			issues = append(issues, fmt.Sprintf("%d|%d|data mismatch", event.ID, event.BlockNumber))
+		case heimdallEvent.TxHash != event.TxHash:
+			issues = append(issues, fmt.Sprintf("%d|%d|tx hash mismatch, heimdall has %s", event.ID, event.BlockNumber, heimdallEvent.TxHash))
+		}
+	}
+
+	if len(issues) == 1 {
+		return nil
+	}
+
+	return issues
+}
+
+func formatStateSyncEvents(events []*bor.StateSyncEvent) string {
+	if len(events) == 0 {
+		return "No state-sync events found"
+	}
+
+	rows := make([]string, len(events)+1)
+	rows[0] = "ID|Block|Contract|Time|Tx Hash"
+
+	for i, event := range events {
+		rows[i+1] = fmt.Sprintf("%d|%d|%s|%s|%s",
+			event.ID,
+			event.BlockNumber,
+			event.Contract,
+			event.Time.Format(time.RFC3339),
+			event.TxHash,
+		)
+	}
+
+	return formatList(rows)
+}
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+)
+
+func TestCheckStateSyncEvents(t *testing.T) {
+	t.Parallel()
+
+	contract := common.HexToAddress("0x1001")
+
+	events := []*bor.StateSyncEvent{
+		{ID: 1, Contract: contract, Data: []byte{0x1}, BlockNumber: 16},
+		{ID: 2, Contract: contract, Data: []byte{0x2}, BlockNumber: 16},
+		{ID: 5, Contract: contract, Data: []byte{0x5}, BlockNumber: 32},
+	}
+
+	heimdallEvents := []*clerk.EventRecordWithTime{
+		{EventRecord: clerk.EventRecord{ID: 1, Contract: contract, Data: []byte{0x1}}},
+		{EventRecord: clerk.EventRecord{ID: 2, Contract: contract, Data: []byte{0xff}}},
+		{EventRecord: clerk.EventRecord{ID: 3, Contract: contract, Data: []byte{0x3}}},
+		{EventRecord: clerk.EventRecord{ID: 4, Contract: contract, Data: []byte{0x4}}},
+		{EventRecord: clerk.EventRecord{ID: 5, Contract: contract, Data: []byte{0x5}}},
+	}
+
+	issues := checkStateSyncEvents(events, heimdallEvents, true)
+	require.Equal(t, []string{
+		"ID|Block|Issue",
+		"2|16|data mismatch",
+		"3|<none>|skipped",
+		"4|<none>|skipped",
+	}, issues)
+
+	// without heimdall only the sequence of ids is checked
+	issues = checkStateSyncEvents(events, nil, false)
+	require.Len(t, issues, 3)
+
+	require.Nil(t, checkStateSyncEvents(events[:2], heimdallEvents[:1], false))
+}
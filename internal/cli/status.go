@@ -111,5 +111,16 @@ func printStatus(status *proto.StatusResponse) string {
 		formatList(forks),
 	}
 
+	if len(status.HeimdallEndpoints) > 0 {
+		endpoints := make([]string, len(status.HeimdallEndpoints)+1)
+		endpoints[0] = "Endpoint|Healthy|Requests|Errors|Latency (ms)|Last error"
+
+		for i, e := range status.HeimdallEndpoints {
+			endpoints[i+1] = fmt.Sprintf("%s|%v|%d|%d|%d|%s", e.Name, e.Healthy, e.Requests, e.Errors, e.LatencyMs, e.LastError)
+		}
+
+		full = append(full, "\nHeimdall Endpoints", formatList(endpoints))
+	}
+
 	return strings.Join(full, "\n")
 }
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// WhitelistCommand is the command to group the whitelist commands
+type WhitelistCommand struct {
+	UI cli.Ui
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *WhitelistCommand) MarkDown() string {
+	items := []string{
+		"# Whitelist",
+		"The ```whitelist``` command groups actions to interact with the checkpoint whitelist of the client:",
+		"- [```whitelist list```](./whitelist_list.md): Lists the whitelisted checkpoints.",
+		"- [```whitelist purge```](./whitelist_purge.md): Purges the whitelisted checkpoints.",
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *WhitelistCommand) Help() string {
+	return `Usage: bor whitelist <subcommand>
+
+  This command groups actions to interact with the checkpoint whitelist.
+
+  List the whitelisted checkpoints:
+
+    $ bor whitelist list
+
+  Purge the whitelisted checkpoints:
+
+    $ bor whitelist purge`
+}
+
+// Synopsis implements the cli.Command interface
+func (c *WhitelistCommand) Synopsis() string {
+	return "Interact with the checkpoint whitelist"
+}
+
+// Run implements the cli.Command interface
+func (c *WhitelistCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// WhitelistListCommand is the command to list the whitelisted checkpoints
+type WhitelistListCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *WhitelistListCommand) MarkDown() string {
+	items := []string{
+		"# Whitelist list",
+		"The ```whitelist list``` command lists the whitelisted checkpoints, which the client won't reorg past.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *WhitelistListCommand) Help() string {
+	return `Usage: bor whitelist list
+
+  Lists the whitelisted checkpoints
+
+  ` + c.Flags().Help()
+}
+
+func (c *WhitelistListCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("whitelist list")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *WhitelistListCommand) Synopsis() string {
+	return "List the whitelisted checkpoints"
+}
+
+// Run implements the cli.Command interface
+func (c *WhitelistListCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	resp, err := borClt.WhitelistList(context.Background(), &proto.WhitelistListRequest{})
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(formatWhitelist(resp.Checkpoints))
+
+	return 0
+}
+
+func formatWhitelist(checkpoints []*proto.Header) string {
+	if len(checkpoints) == 0 {
+		return "No whitelisted checkpoints found"
+	}
+
+	rows := make([]string, len(checkpoints)+1)
+	rows[0] = "Block|Hash"
+
+	for i, checkpoint := range checkpoints {
+		rows[i+1] = fmt.Sprintf("%d|%s", checkpoint.Number, checkpoint.Hash)
+	}
+
+	return formatList(rows)
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// WhitelistPurgeCommand is the command to purge the whitelisted checkpoints
+type WhitelistPurgeCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *WhitelistPurgeCommand) MarkDown() string {
+	items := []string{
+		"# Whitelist purge",
+		"The ```whitelist purge``` command purges the whitelisted checkpoints, in memory and in the database. " +
+			"They are fetched again from Heimdall by the client.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *WhitelistPurgeCommand) Help() string {
+	return `Usage: bor whitelist purge
+
+  Purges the whitelisted checkpoints
+
+  ` + c.Flags().Help()
+}
+
+func (c *WhitelistPurgeCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("whitelist purge")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *WhitelistPurgeCommand) Synopsis() string {
+	return "Purge the whitelisted checkpoints"
+}
+
+// Run implements the cli.Command interface
+func (c *WhitelistPurgeCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if _, err := borClt.WhitelistPurge(context.Background(), &proto.WhitelistPurgeRequest{}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Checkpoint whitelist purged")
+
+	return 0
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/tyler-smith/go-bip39"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -35,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/common/tracing"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/consensus/misc"
@@ -257,6 +259,43 @@ func (s *PublicTxPoolAPI) Inspect() map[string]map[string]map[string]string {
 	return content
 }
 
+// DropReason returns the reason the given transaction was dropped from the
+// transaction pool, or nil if it isn't among the recent drops.
+func (s *PublicTxPoolAPI) DropReason(hash common.Hash) *core.TxDropEvent {
+	return s.b.TxPoolDropReason(hash)
+}
+
+// DroppedTransactions creates a subscription that is triggered each time a
+// transaction is dropped from the transaction pool.
+func (s *PublicTxPoolAPI) DroppedTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		drops := make(chan core.TxDropEvent, 128)
+		sub := s.b.SubscribeDropTxsEvent(drops)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case drop := <-drops:
+				notifier.Notify(rpcSub.ID, drop)
+			case <-sub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 // PublicAccountAPI provides an API to access accounts managed by this node.
 // It offers only methods that can retrieve accounts.
 type PublicAccountAPI struct {
@@ -990,6 +1029,9 @@ func (diff *StateOverride) Apply(state *state.StateDB) error {
 func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, timeout time.Duration, globalGasCap uint64) (*core.ExecutionResult, error) {
 	defer func(start time.Time) { log.Debug("Executing EVM call finished", "runtime", time.Since(start)) }(time.Now())
 
+	ctx, span := tracing.StartSpan(ctx, "ethapi.DoCall")
+	defer tracing.EndSpan(span)
+
 	state, header, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
 	if state == nil || err != nil {
 		return nil, err
@@ -1027,8 +1069,17 @@ func DoCall(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash
 
 	// Execute the message.
 	gp := new(core.GasPool).AddGas(math.MaxUint64)
+	_, execSpan := tracing.StartSpan(ctx, "ethapi.ApplyMessage")
 	// nolint : contextcheck
 	result, err := core.ApplyMessage(evm, msg, gp, context.Background())
+	if result != nil {
+		tracing.SetAttributes(
+			execSpan,
+			attribute.Int("gas used", int(result.UsedGas)),
+			attribute.Bool("failed", result.Failed()),
+		)
+	}
+	tracing.EndSpan(execSpan)
 	if err := vmError(); err != nil {
 		return nil, err
 	}
@@ -1097,6 +1148,9 @@ func (s *PublicBlockChainAPI) Call(ctx context.Context, args TransactionArgs, bl
 }
 
 func DoEstimateGas(ctx context.Context, b Backend, args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, gasCap uint64) (hexutil.Uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, "ethapi.DoEstimateGas")
+	defer tracing.EndSpan(span)
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo  uint64 = params.TxGas - 1
@@ -1955,6 +2009,55 @@ func (s *PublicTransactionPoolAPI) SendRawTransaction(ctx context.Context, input
 	return SubmitTransaction(ctx, s.b, tx)
 }
 
+// DefaultPrivateTxBlocks is the number of blocks a private transaction can be
+// included in, if no deadline is given.
+const DefaultPrivateTxBlocks = 25
+
+// MaxPrivateTxBlocks is the maximum number of blocks a private transaction can
+// be included in.
+const MaxPrivateTxBlocks = 1000
+
+// PrivateTransactionArgs are the arguments of eth_sendPrivateTransaction.
+type PrivateTransactionArgs struct {
+	Tx             hexutil.Bytes   `json:"tx"`
+	MaxBlockNumber *hexutil.Uint64 `json:"maxBlockNumber"` // Last block the transaction can be included in
+	Publish        bool            `json:"publish"`        // Whether to broadcast the transaction once expired
+}
+
+// SendPrivateTransaction adds the signed transaction to the private section of
+// the transaction pool. The transaction is never broadcast, and only included by
+// this node when it is the primary block producer, up to the given block. It is
+// then dropped, or broadcast if requested.
+func (s *PublicTransactionPoolAPI) SendPrivateTransaction(ctx context.Context, args PrivateTransactionArgs) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(args.Tx); err != nil {
+		return common.Hash{}, err
+	}
+	if err := checkTxFee(tx.GasPrice(), tx.Gas(), s.b.RPCTxFeeCap()); err != nil {
+		return common.Hash{}, err
+	}
+	if !s.b.UnprotectedAllowed() && !tx.Protected() {
+		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+	}
+	head := s.b.CurrentHeader().Number.Uint64()
+
+	maxBlock := head + DefaultPrivateTxBlocks
+	if args.MaxBlockNumber != nil {
+		maxBlock = uint64(*args.MaxBlockNumber)
+	}
+	if maxBlock <= head {
+		return common.Hash{}, fmt.Errorf("max block number %d already reached", maxBlock)
+	}
+	if maxBlock > head+MaxPrivateTxBlocks {
+		return common.Hash{}, fmt.Errorf("max block number %d is more than %d blocks ahead", maxBlock, MaxPrivateTxBlocks)
+	}
+	if err := s.b.SendPrivateTx(ctx, tx, maxBlock, args.Publish); err != nil {
+		return common.Hash{}, err
+	}
+	log.Info("Submitted private transaction", "hash", tx.Hash().Hex(), "nonce", tx.Nonce(), "maxBlock", maxBlock, "publish", args.Publish)
+	return tx.Hash(), nil
+}
+
 // Sign calculates an ECDSA signature for:
 // keccack256("\x19Ethereum Signed Message:\n" + len(message) + message).
 //
@@ -0,0 +1,226 @@
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// chainContext is a core.ChainContext backed by the API backend.
+type chainContext struct {
+	ctx context.Context
+	b   Backend
+}
+
+func (c *chainContext) Engine() consensus.Engine {
+	return c.b.Engine()
+}
+
+func (c *chainContext) GetHeader(hash common.Hash, number uint64) *types.Header {
+	header, err := c.b.HeaderByHash(c.ctx, hash)
+	if err != nil || header == nil || header.Number.Uint64() != number {
+		return nil
+	}
+	return header
+}
+
+// decodeBundleTxs decodes the signed transactions of a bundle.
+func decodeBundleTxs(encoded []hexutil.Bytes) (types.Transactions, error) {
+	if len(encoded) == 0 {
+		return nil, core.ErrEmptyBundle
+	}
+	txs := make(types.Transactions, 0, len(encoded))
+	for i, input := range encoded {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(input); err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// SendBundleArgs are the arguments of eth_sendBundle.
+type SendBundleArgs struct {
+	Txs               []hexutil.Bytes `json:"txs"`
+	BlockNumber       hexutil.Uint64  `json:"blockNumber"`       // Block the bundle must be included in
+	MinTimestamp      *uint64         `json:"minTimestamp"`      // Minimum block timestamp
+	MaxTimestamp      *uint64         `json:"maxTimestamp"`      // Maximum block timestamp
+	RevertingTxHashes []common.Hash   `json:"revertingTxHashes"` // Transactions allowed to revert
+}
+
+// SendBundle adds an ordered bundle of signed transactions to the transaction
+// pool. The transactions are included atomically at the top of the given block,
+// or not at all, and are never broadcast. The bundle hash is returned.
+func (s *PublicTransactionPoolAPI) SendBundle(ctx context.Context, args SendBundleArgs) (common.Hash, error) {
+	txs, err := decodeBundleTxs(args.Txs)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	for _, tx := range txs {
+		if err := checkTxFee(tx.GasPrice(), tx.Gas(), s.b.RPCTxFeeCap()); err != nil {
+			return common.Hash{}, err
+		}
+		if !s.b.UnprotectedAllowed() && !tx.Protected() {
+			return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
+	}
+	bundle := &core.Bundle{
+		Txs:               txs,
+		BlockNumber:       uint64(args.BlockNumber),
+		RevertingTxHashes: args.RevertingTxHashes,
+	}
+	if args.MinTimestamp != nil {
+		bundle.MinTimestamp = *args.MinTimestamp
+	}
+	if args.MaxTimestamp != nil {
+		bundle.MaxTimestamp = *args.MaxTimestamp
+	}
+	if err := s.b.SendBundle(ctx, bundle); err != nil {
+		return common.Hash{}, err
+	}
+	log.Info("Submitted bundle", "hash", bundle.Hash(), "txs", len(txs), "block", bundle.BlockNumber)
+	return bundle.Hash(), nil
+}
+
+// CallBundleArgs are the arguments of eth_callBundle.
+type CallBundleArgs struct {
+	Txs              []hexutil.Bytes       `json:"txs"`
+	BlockNumber      *hexutil.Uint64       `json:"blockNumber"`      // Block the bundle is simulated in, the next one by default
+	StateBlockNumber rpc.BlockNumberOrHash `json:"stateBlockNumber"` // Block whose state the bundle is simulated on
+	Coinbase         *common.Address       `json:"coinbase"`         // Fee recipient, the producer of the parent by default
+	Timestamp        *uint64               `json:"timestamp"`        // Block timestamp, the next one by default
+}
+
+// CallBundleTxResult is the outcome of a transaction of a simulated bundle.
+type CallBundleTxResult struct {
+	TxHash       common.Hash    `json:"txHash"`
+	From         common.Address `json:"fromAddress"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	CoinbaseDiff *hexutil.Big   `json:"coinbaseDiff"`
+	Reverted     bool           `json:"reverted"`
+}
+
+// CallBundleResult is the outcome of a simulated bundle.
+type CallBundleResult struct {
+	BundleHash       common.Hash          `json:"bundleHash"`
+	Results          []CallBundleTxResult `json:"results"`
+	TotalGasUsed     hexutil.Uint64       `json:"totalGasUsed"`
+	CoinbaseDiff     *hexutil.Big         `json:"coinbaseDiff"`
+	BundleGasPrice   *hexutil.Big         `json:"bundleGasPrice"` // Coinbase profit per unit of gas
+	StateBlockNumber hexutil.Uint64       `json:"stateBlockNumber"`
+}
+
+// CallBundle simulates an ordered bundle of signed transactions on top of the
+// state of the given block, as the miner would before including it, and returns
+// the gas used and the profit of the block producer. Failing transactions abort
+// the simulation, reverting ones are reported.
+func (s *PublicBlockChainAPI) CallBundle(ctx context.Context, args CallBundleArgs) (*CallBundleResult, error) {
+	defer func(start time.Time) { log.Debug("Executing bundle call finished", "runtime", time.Since(start)) }(time.Now())
+
+	txs, err := decodeBundleTxs(args.Txs)
+	if err != nil {
+		return nil, err
+	}
+	if args.StateBlockNumber.BlockNumber == nil && args.StateBlockNumber.BlockHash == nil {
+		args.StateBlockNumber = rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	}
+	state, parent, err := s.b.StateAndHeaderByNumberOrHash(ctx, args.StateBlockNumber)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	if timeout := s.b.RPCEVMTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	config := s.b.ChainConfig()
+	number := parent.Number.Uint64() + 1
+	if args.BlockNumber != nil {
+		number = uint64(*args.BlockNumber)
+	}
+	timestamp := nextBlockTime(config, parent, number)
+	if args.Timestamp != nil {
+		timestamp = *args.Timestamp
+	}
+	// The fees go to the block producer, which isn't the coinbase of the header
+	// on Bor, so the producer of the parent is the default fee recipient
+	var coinbase common.Address
+	if args.Coinbase != nil {
+		coinbase = *args.Coinbase
+	} else if coinbase, err = s.b.Engine().Author(parent); err != nil {
+		return nil, err
+	}
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).SetUint64(number),
+		GasLimit:   parent.GasLimit,
+		Time:       timestamp,
+		Difficulty: parent.Difficulty,
+		Coinbase:   coinbase,
+	}
+	if config.IsLondon(header.Number) {
+		header.BaseFee = misc.CalcBaseFee(config, parent)
+	}
+
+	var (
+		signer   = types.MakeSigner(config, header.Number)
+		chain    = &chainContext{ctx: ctx, b: s.b}
+		gp       = new(core.GasPool).AddGas(header.GasLimit)
+		balance  = state.GetBalance(coinbase)
+		gasUsed  uint64
+		results  = make([]CallBundleTxResult, 0, len(txs))
+		vmConfig = vm.Config{}
+	)
+	for i, tx := range txs {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("execution aborted: %w", err)
+		}
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		txBalance := state.GetBalance(coinbase)
+
+		state.Prepare(tx.Hash(), i)
+		receipt, err := core.ApplyTransaction(config, chain, &coinbase, gp, state, header, tx, &gasUsed, vmConfig, nil)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", tx.Hash(), err)
+		}
+		results = append(results, CallBundleTxResult{
+			TxHash:       tx.Hash(),
+			From:         from,
+			GasUsed:      hexutil.Uint64(receipt.GasUsed),
+			CoinbaseDiff: (*hexutil.Big)(new(big.Int).Sub(state.GetBalance(coinbase), txBalance)),
+			Reverted:     receipt.Status == types.ReceiptStatusFailed,
+		})
+	}
+
+	profit := new(big.Int).Sub(state.GetBalance(coinbase), balance)
+	price := new(big.Int)
+	if gasUsed > 0 {
+		price.Div(profit, new(big.Int).SetUint64(gasUsed))
+	}
+	bundle := &core.Bundle{Txs: txs}
+	return &CallBundleResult{
+		BundleHash:       bundle.Hash(),
+		Results:          results,
+		TotalGasUsed:     hexutil.Uint64(gasUsed),
+		CoinbaseDiff:     (*hexutil.Big)(profit),
+		BundleGasPrice:   (*hexutil.Big)(price),
+		StateBlockNumber: hexutil.Uint64(parent.Number.Uint64()),
+	}, nil
+}
@@ -0,0 +1,272 @@
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// maxSimulateBlocks is the maximum number of blocks eth_simulateV1 can simulate.
+const maxSimulateBlocks = 256
+
+// BlockOverrides is a set of header fields to override when simulating a block.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	GasLimit *hexutil.Uint64 `json:"gasLimit"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// Apply overrides the fields of the given header.
+func (o *BlockOverrides) Apply(header *types.Header) {
+	if o == nil {
+		return
+	}
+	if o.Number != nil {
+		header.Number = new(big.Int).Set(o.Number.ToInt())
+	}
+	if o.Time != nil {
+		header.Time = uint64(*o.Time)
+	}
+	if o.GasLimit != nil {
+		header.GasLimit = uint64(*o.GasLimit)
+	}
+	if o.Coinbase != nil {
+		header.Coinbase = *o.Coinbase
+	}
+	if o.BaseFee != nil {
+		header.BaseFee = new(big.Int).Set(o.BaseFee.ToInt())
+	}
+}
+
+// SimBlock is a block to simulate, made of calls executed on top of the state
+// left by the previous block, once the overrides are applied.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides *StateOverride    `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimOpts are the arguments of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	Validation      bool       `json:"validation"` // Whether to enforce the base fee
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimCallResult is the outcome of a simulated call.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	Logs       []*types.Log   `json:"logs"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Status     hexutil.Uint64 `json:"status"`
+	Error      *SimCallError  `json:"error,omitempty"`
+}
+
+// SimBlockResult is the outcome of a simulated block.
+type SimBlockResult struct {
+	Number    hexutil.Uint64  `json:"number"`
+	Hash      common.Hash     `json:"hash"`
+	Timestamp hexutil.Uint64  `json:"timestamp"`
+	GasLimit  hexutil.Uint64  `json:"gasLimit"`
+	GasUsed   hexutil.Uint64  `json:"gasUsed"`
+	Coinbase  common.Address  `json:"coinbase"`
+	BaseFee   *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	Calls     []SimCallResult `json:"calls"`
+}
+
+// nextBlockTime returns the default timestamp of the block with the given
+// number following parent.
+func nextBlockTime(config *params.ChainConfig, parent *types.Header, number uint64) uint64 {
+	if config.Bor != nil {
+		return parent.Time + config.Bor.CalculatePeriod(number)
+	}
+	return parent.Time + 1
+}
+
+// SimulateV1 executes a sequence of blocks of calls on top of the state of the
+// given block, each call seeing the effects of the previous ones. The header
+// fields and the state can be overridden before each block. The logs, including
+// the Bor fee transfer ones, the gas used and the revert reason of each call are
+// returned. Note the hashes of the simulated blocks are not available to the
+// BLOCKHASH opcode.
+func (s *PublicBlockChainAPI) SimulateV1(ctx context.Context, opts SimOpts, blockNrOrHash *rpc.BlockNumberOrHash) ([]*SimBlockResult, error) {
+	defer func(start time.Time) { log.Debug("Executing simulation finished", "runtime", time.Since(start)) }(time.Now())
+
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, fmt.Errorf("too many blocks: %d, max %d", len(opts.BlockStateCalls), maxSimulateBlocks)
+	}
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+	state, parent, err := s.b.StateAndHeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+
+	var cancel context.CancelFunc
+	if timeout := s.b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	// A single goroutine cancels the EVM of the call being executed once the
+	// request is cancelled or times out
+	var current atomic.Pointer[vm.EVM]
+	go func() {
+		<-ctx.Done()
+		if evm := current.Load(); evm != nil {
+			evm.Cancel()
+		}
+	}()
+
+	// The fees go to the block producer, which isn't the coinbase of the header
+	// on Bor, so the producer of the base block is the default fee recipient
+	coinbase, err := s.b.Engine().Author(parent)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		config   = s.b.ChainConfig()
+		chain    = &chainContext{ctx: ctx, b: s.b}
+		vmConfig = vm.Config{NoBaseFee: !opts.Validation}
+		results  = make([]*SimBlockResult, 0, len(opts.BlockStateCalls))
+	)
+	for _, block := range opts.BlockStateCalls {
+		number := new(big.Int).Add(parent.Number, common.Big1)
+		header := &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     number,
+			GasLimit:   parent.GasLimit,
+			Time:       nextBlockTime(config, parent, number.Uint64()),
+			Difficulty: parent.Difficulty,
+			Coinbase:   coinbase,
+		}
+		if config.IsLondon(number) {
+			header.BaseFee = misc.CalcBaseFee(config, parent)
+		}
+		block.BlockOverrides.Apply(header)
+
+		if header.Number.Cmp(parent.Number) <= 0 {
+			return nil, fmt.Errorf("block number %v not greater than %v", header.Number, parent.Number)
+		}
+		if header.Time <= parent.Time {
+			return nil, fmt.Errorf("block timestamp %d not greater than %d", header.Time, parent.Time)
+		}
+		if err := block.StateOverrides.Apply(state); err != nil {
+			return nil, err
+		}
+
+		var (
+			gp       = new(core.GasPool).AddGas(header.GasLimit)
+			calls    = make([]SimCallResult, 0, len(block.Calls))
+			blockCtx = core.NewEVMBlockContext(header, chain, &header.Coinbase)
+		)
+		for i, args := range block.Calls {
+			if args.Gas == nil {
+				remaining := hexutil.Uint64(gp.Gas())
+				args.Gas = &remaining
+			}
+			msg, err := args.ToMessage(s.b.RPCGasCap(), header.BaseFee)
+			if err != nil {
+				return nil, fmt.Errorf("block %v call %d: %w", header.Number, i, err)
+			}
+			// Logs are kept by the state per transaction hash, make one up
+			// from the position of the call
+			hash := crypto.Keccak256Hash(common.BigToHash(header.Number).Bytes(), common.BigToHash(big.NewInt(int64(i))).Bytes())
+			state.Prepare(hash, i)
+
+			evm := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), state, config, vmConfig)
+			current.Store(evm)
+
+			// Checked after publishing the EVM, for a cancellation racing with
+			// the store to either be seen here or cancel the new EVM
+			if err := ctx.Err(); err != nil {
+				return nil, fmt.Errorf("execution aborted: %w", err)
+			}
+
+			// nolint : contextcheck
+			result, err := core.ApplyMessage(evm, msg, gp, context.Background())
+			if evm.Cancelled() {
+				return nil, fmt.Errorf("execution aborted (timeout = %v)", s.b.RPCEVMTimeout())
+			}
+			if err != nil {
+				return nil, fmt.Errorf("block %v call %d: %w", header.Number, i, err)
+			}
+			if limit := int(s.b.RPCRpcReturnDataLimit()); limit > 0 && len(result.ReturnData) > limit {
+				return nil, fmt.Errorf("block %v call %d returned result of length %d exceeding limit %d", header.Number, i, len(result.ReturnData), limit)
+			}
+			state.Finalise(true)
+			header.GasUsed += result.UsedGas
+
+			call := SimCallResult{
+				ReturnData: result.Return(),
+				Logs:       state.GetLogs(hash, common.Hash{}),
+				GasUsed:    hexutil.Uint64(result.UsedGas),
+				Status:     hexutil.Uint64(types.ReceiptStatusSuccessful),
+			}
+			if result.Failed() {
+				call.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+				call.ReturnData = result.Revert()
+				call.Error = &SimCallError{Code: -32015, Message: result.Err.Error()}
+				if len(result.Revert()) > 0 {
+					revertErr := newRevertError(result)
+					call.Error = &SimCallError{Code: revertErr.ErrorCode(), Message: revertErr.Error(), Data: revertErr.reason}
+				}
+			}
+			if call.Logs == nil {
+				call.Logs = []*types.Log{}
+			}
+			calls = append(calls, call)
+		}
+
+		// The block hash depends on the gas used, so it's only known now
+		hash := header.Hash()
+		for _, call := range calls {
+			for _, l := range call.Logs {
+				l.BlockHash = hash
+				l.BlockNumber = header.Number.Uint64()
+			}
+		}
+		results = append(results, &SimBlockResult{
+			Number:    hexutil.Uint64(header.Number.Uint64()),
+			Hash:      hash,
+			Timestamp: hexutil.Uint64(header.Time),
+			GasLimit:  hexutil.Uint64(header.GasLimit),
+			GasUsed:   hexutil.Uint64(header.GasUsed),
+			Coinbase:  header.Coinbase,
+			BaseFee:   (*hexutil.Big)(header.BaseFee),
+			Calls:     calls,
+		})
+		parent = header
+	}
+	return results, nil
+}
@@ -0,0 +1,285 @@
+package ethapi
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	simSender  = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	simCounter = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	simProbe   = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	simReverts = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	simLoops   = common.HexToAddress("0x6000000000000000000000000000000000000006")
+	simAuthor  = common.HexToAddress("0x7000000000000000000000000000000000000007")
+
+	// Increments the slot 0 and returns its new value
+	simCounterCode = common.FromHex("0x6000546001018060005560005260206000f3")
+	// Returns the number, the timestamp and the coinbase of the block
+	simProbeCode = common.FromHex("0x43600052426020524160405260606000f3")
+	// Reverts with Error("boom")
+	simRevertsCode = common.FromHex("0x6064600c60003960646000fd" +
+		"08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"626f6f6d00000000000000000000000000000000000000000000000000000000")
+	// Loops forever
+	simLoopsCode = common.FromHex("0x5b600056")
+)
+
+// simEngine is a consensus engine attributing all the blocks to simAuthor.
+type simEngine struct {
+	consensus.Engine
+}
+
+func (simEngine) Author(*types.Header) (common.Address, error) { return simAuthor, nil }
+
+// simBackend is the part of the backend used by eth_simulateV1, serving the
+// state of a genesis block.
+type simBackend struct {
+	Backend
+
+	db      state.Database
+	genesis *types.Block
+	config  *params.ChainConfig
+	timeout time.Duration
+}
+
+func newSimBackend(t *testing.T) *simBackend {
+	t.Helper()
+
+	config := params.BorUnittestChainConfig
+	genesis := &core.Genesis{
+		Config:   config,
+		GasLimit: 30_000_000,
+		BaseFee:  big.NewInt(params.InitialBaseFee),
+		Alloc: core.GenesisAlloc{
+			simSender:  {Balance: new(big.Int).Mul(big.NewInt(params.Ether), big.NewInt(100))},
+			simCounter: {Balance: common.Big0, Code: simCounterCode},
+			simProbe:   {Balance: common.Big0, Code: simProbeCode},
+			simReverts: {Balance: common.Big0, Code: simRevertsCode},
+			simLoops:   {Balance: common.Big0, Code: simLoopsCode},
+		},
+	}
+	db := rawdb.NewMemoryDatabase()
+
+	return &simBackend{db: state.NewDatabase(db), genesis: genesis.MustCommit(db), config: config}
+}
+
+func (b *simBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+	statedb, err := state.New(b.genesis.Root(), b.db, nil)
+	return statedb, b.genesis.Header(), err
+}
+
+func (b *simBackend) ChainConfig() *params.ChainConfig { return b.config }
+func (b *simBackend) Engine() consensus.Engine         { return simEngine{} }
+func (b *simBackend) RPCGasCap() uint64                { return 50_000_000 }
+func (b *simBackend) RPCRpcReturnDataLimit() uint64    { return 0 }
+func (b *simBackend) RPCEVMTimeout() time.Duration     { return b.timeout }
+func (b *simBackend) HeaderByHash(context.Context, common.Hash) (*types.Header, error) {
+	return nil, nil
+}
+
+func simCall(to common.Address) TransactionArgs {
+	from := simSender
+	return TransactionArgs{From: &from, To: &to}
+}
+
+func TestSimulateV1(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b         = newSimBackend(t)
+		api       = NewPublicBlockChainAPI(b)
+		number    = (*hexutil.Big)(big.NewInt(10))
+		timestamp = hexutil.Uint64(b.genesis.Time() + 100)
+		coinbase  = common.HexToAddress("0x5000000000000000000000000000000000000005")
+		price     = (*hexutil.Big)(big.NewInt(10 * params.GWei))
+	)
+	paid := simCall(simCounter)
+	paid.GasPrice = price
+
+	results, err := api.SimulateV1(context.Background(), SimOpts{
+		BlockStateCalls: []SimBlock{
+			{Calls: []TransactionArgs{simCall(simCounter), simCall(simCounter)}},
+			{
+				BlockOverrides: &BlockOverrides{Number: number, Time: &timestamp, Coinbase: &coinbase},
+				Calls:          []TransactionArgs{simCall(simCounter), simCall(simProbe), paid, simCall(simReverts)},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to simulate: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("block count mismatch: have %d, want 2", len(results))
+	}
+
+	// The first block follows the genesis, the fees going to its producer
+	first := results[0]
+	if first.Number != 1 {
+		t.Errorf("first block number mismatch: have %d, want 1", first.Number)
+	}
+	if first.Coinbase != simAuthor {
+		t.Errorf("first block coinbase mismatch: have %x, want %x", first.Coinbase, simAuthor)
+	}
+	if want := b.genesis.Time() + b.config.Bor.CalculatePeriod(1); uint64(first.Timestamp) != want {
+		t.Errorf("first block timestamp mismatch: have %d, want %d", first.Timestamp, want)
+	}
+
+	// The state is carried between the calls and the blocks
+	for i, call := range append(first.Calls, results[1].Calls[0]) {
+		if call.Error != nil {
+			t.Fatalf("counter call %d failed: %v", i, call.Error.Message)
+		}
+		if have, want := new(big.Int).SetBytes(call.ReturnData), big.NewInt(int64(i+1)); have.Cmp(want) != 0 {
+			t.Errorf("counter call %d result mismatch: have %v, want %v", i, have, want)
+		}
+	}
+	if first.GasUsed != first.Calls[0].GasUsed+first.Calls[1].GasUsed {
+		t.Errorf("first block gas used mismatch: have %d, want %d", first.GasUsed, first.Calls[0].GasUsed+first.Calls[1].GasUsed)
+	}
+
+	// The header fields are overridden, and seen by the calls
+	second := results[1]
+	if second.Number != 10 || uint64(second.Timestamp) != uint64(timestamp) || second.Coinbase != coinbase {
+		t.Errorf("second block header mismatch: have number %d, time %d, coinbase %x", second.Number, second.Timestamp, second.Coinbase)
+	}
+	if probe := second.Calls[1].ReturnData; len(probe) != 96 {
+		t.Errorf("probe result length mismatch: have %d, want 96", len(probe))
+	} else {
+		if have := new(big.Int).SetBytes(probe[:32]); have.Cmp(number.ToInt()) != 0 {
+			t.Errorf("probed number mismatch: have %v, want %v", have, number)
+		}
+		if have := new(big.Int).SetBytes(probe[32:64]).Uint64(); have != uint64(timestamp) {
+			t.Errorf("probed timestamp mismatch: have %d, want %d", have, timestamp)
+		}
+		if have := common.BytesToAddress(probe[64:]); have != coinbase {
+			t.Errorf("probed coinbase mismatch: have %x, want %x", have, coinbase)
+		}
+	}
+
+	// Paying a tip transfers the fee to the coinbase, logged by Bor
+	logs := second.Calls[2].Logs
+	if len(logs) != 1 {
+		t.Fatalf("fee transfer log count mismatch: have %d, want 1", len(logs))
+	}
+	if logs[0].Address != common.HexToAddress("0x0000000000000000000000000000000000001010") {
+		t.Errorf("fee transfer log address mismatch: have %x", logs[0].Address)
+	}
+	if len(logs[0].Topics) != 4 || logs[0].Topics[2] != simSender.Hash() || logs[0].Topics[3] != coinbase.Hash() {
+		t.Errorf("fee transfer log topics mismatch: have %v", logs[0].Topics)
+	}
+	if logs[0].BlockHash != second.Hash || logs[0].BlockNumber != 10 {
+		t.Errorf("fee transfer log block mismatch: have %x #%d, want %x #10", logs[0].BlockHash, logs[0].BlockNumber, second.Hash)
+	}
+	for i, call := range second.Calls[:2] {
+		if len(call.Logs) != 0 {
+			t.Errorf("call %d without tip has %d logs", i, len(call.Logs))
+		}
+	}
+
+	// The revert reason is decoded
+	reverted := second.Calls[3]
+	if reverted.Status != hexutil.Uint64(types.ReceiptStatusFailed) {
+		t.Errorf("reverted call status mismatch: have %d, want %d", reverted.Status, types.ReceiptStatusFailed)
+	}
+	if reverted.Error == nil {
+		t.Fatal("reverted call has no error")
+	}
+	if reverted.Error.Code != 3 || reverted.Error.Message != "execution reverted: boom" {
+		t.Errorf("reverted call error mismatch: have %d %q", reverted.Error.Code, reverted.Error.Message)
+	}
+	if want := hexutil.Encode(simRevertsCode[12:]); reverted.Error.Data != want || hexutil.Encode(reverted.ReturnData) != want {
+		t.Errorf("reverted call data mismatch: have %s, want %s", reverted.Error.Data, want)
+	}
+}
+
+func TestSimulateV1Errors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		b       = newSimBackend(t)
+		api     = NewPublicBlockChainAPI(b)
+		genesis = hexutil.Uint64(b.genesis.Time())
+		later   = hexutil.Uint64(b.genesis.Time() + 10)
+		number  = (*hexutil.Big)(big.NewInt(5))
+	)
+	tests := []struct {
+		name   string
+		blocks []SimBlock
+		err    string
+	}{
+		{
+			name: "empty",
+			err:  "empty input",
+		},
+		{
+			name:   "too many blocks",
+			blocks: make([]SimBlock, maxSimulateBlocks+1),
+			err:    "too many blocks: 257, max 256",
+		},
+		{
+			name:   "timestamp of the parent",
+			blocks: []SimBlock{{BlockOverrides: &BlockOverrides{Time: &genesis}}},
+			err:    "block timestamp",
+		},
+		{
+			name: "timestamp before the previous block",
+			blocks: []SimBlock{
+				{BlockOverrides: &BlockOverrides{Time: &later}},
+				{BlockOverrides: &BlockOverrides{Time: &later}},
+			},
+			err: "block timestamp",
+		},
+		{
+			name: "number before the previous block",
+			blocks: []SimBlock{
+				{BlockOverrides: &BlockOverrides{Number: number}},
+				{BlockOverrides: &BlockOverrides{Number: number}},
+			},
+			err: "block number 5 not greater than 5",
+		},
+	}
+	for _, test := range tests {
+		_, err := api.SimulateV1(context.Background(), SimOpts{BlockStateCalls: test.blocks}, nil)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: error mismatch: have %v, want %q", test.name, err, test.err)
+		}
+	}
+
+	// Exactly the maximum number of blocks can be simulated
+	if _, err := api.SimulateV1(context.Background(), SimOpts{BlockStateCalls: make([]SimBlock, maxSimulateBlocks)}, nil); err != nil {
+		t.Errorf("failed to simulate %d blocks: %v", maxSimulateBlocks, err)
+	}
+}
+
+func TestSimulateV1Timeout(t *testing.T) {
+	t.Parallel()
+
+	// Well below the time the loop takes to run out of the gas of the block
+	b := newSimBackend(t)
+	b.timeout = 10 * time.Millisecond
+
+	blocks := []SimBlock{
+		{Calls: []TransactionArgs{simCall(simCounter)}},
+		{Calls: []TransactionArgs{simCall(simCounter), simCall(simLoops)}},
+	}
+	_, err := NewPublicBlockChainAPI(b).SimulateV1(context.Background(), SimOpts{BlockStateCalls: blocks}, nil)
+	if err == nil || !strings.Contains(err.Error(), "execution aborted") {
+		t.Fatalf("error mismatch: have %v, want execution aborted", err)
+	}
+}
@@ -60,6 +60,27 @@ web3._extend({
 			call: 'bor_getRootHash',
 			params: 2,
 		}),
+		new web3._extend.Method({
+			name: 'getSpan',
+			call: 'bor_getSpan',
+			params: 1,
+			inputFormatter: [null]
+		}),
+		new web3._extend.Method({
+			name: 'getSpanHistory',
+			call: 'bor_getSpanHistory',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getStateSyncEvents',
+			call: 'bor_getStateSyncEvents',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'getValidatorPerformance',
+			call: 'bor_getValidatorPerformance',
+			params: 2
+		}),
 	]
 });
 `
@@ -209,16 +209,16 @@ web3._extend({
 			name: 'getExecutionPoolRequestTimeout',
 			call: 'admin_getExecutionPoolRequestTimeout'
 		}),
-		// new web3._extend.Method({
-		// 	name: 'setWSExecutionPoolRequestTimeout',
-		// 	call: 'admin_setWSExecutionPoolRequestTimeout',
-		// 	params: 1
-		// }),
-		// new web3._extend.Method({
-		// 	name: 'setHttpExecutionPoolRequestTimeout',
-		// 	call: 'admin_setHttpExecutionPoolRequestTimeout',
-		// 	params: 1
-		// }),
+		new web3._extend.Method({
+			name: 'setWSExecutionPoolRequestTimeout',
+			call: 'admin_setWSExecutionPoolRequestTimeout',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'setHttpExecutionPoolRequestTimeout',
+			call: 'admin_setHttpExecutionPoolRequestTimeout',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'setWSExecutionPoolSize',
 			call: 'admin_setWSExecutionPoolSize',
@@ -228,6 +228,39 @@ web3._extend({
 			name: 'setHttpExecutionPoolSize',
 			call: 'admin_setHttpExecutionPoolSize',
 		}),
+		new web3._extend.Method({
+			name: 'setHttpModules',
+			call: 'admin_setHttpModules',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'setWSModules',
+			call: 'admin_setWSModules',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'setHttpCorsAndVhosts',
+			call: 'admin_setHttpCorsAndVhosts',
+			params: 2
+		}),
+		new web3._extend.Method({
+			name: 'getRateLimits',
+			call: 'admin_getRateLimits'
+		}),
+		new web3._extend.Method({
+			name: 'setMethodRateLimit',
+			call: 'admin_setMethodRateLimit',
+			params: 4
+		}),
+		new web3._extend.Method({
+			name: 'setIPRateLimit',
+			call: 'admin_setIPRateLimit',
+			params: 3
+		}),
+		new web3._extend.Method({
+			name: 'getQuotaUsage',
+			call: 'admin_getQuotaUsage'
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -510,6 +543,12 @@ web3._extend({
 			params: 2,
 			inputFormatter:[web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter],
 		}),
+		new web3._extend.Method({
+			name: 'blockstmProfile',
+			call: 'debug_blockstmProfile',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter],
+		}),
 		new web3._extend.Method({
 			name: 'getCheckpointWhitelist',
 			call: 'debug_getCheckpointWhitelist',
@@ -556,6 +595,27 @@ web3._extend({
 			params: 3,
 			inputFormatter: [web3._extend.formatters.inputTransactionFormatter, web3._extend.utils.fromDecimal, web3._extend.utils.fromDecimal]
 		}),
+		new web3._extend.Method({
+			name: 'sendPrivateTransaction',
+			call: 'eth_sendPrivateTransaction',
+			params: 1,
+		}),
+		new web3._extend.Method({
+			name: 'sendBundle',
+			call: 'eth_sendBundle',
+			params: 1,
+		}),
+		new web3._extend.Method({
+			name: 'callBundle',
+			call: 'eth_callBundle',
+			params: 1,
+		}),
+		new web3._extend.Method({
+			name: 'simulateV1',
+			call: 'eth_simulateV1',
+			params: 2,
+			inputFormatter: [null, web3._extend.formatters.inputDefaultBlockNumberFormatter],
+		}),
 		new web3._extend.Method({
 			name: 'signTransaction',
 			call: 'eth_signTransaction',
@@ -824,6 +884,11 @@ web3._extend({
 			call: 'txpool_contentFrom',
 			params: 1,
 		}),
+		new web3._extend.Method({
+			name: 'dropReason',
+			call: 'txpool_dropReason',
+			params: 1,
+		}),
 	]
 });
 `
@@ -0,0 +1,251 @@
+package miner
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// maxSimulatedBundles is the maximum number of bundles simulated for a block,
+// out of the pending ones sorted by decreasing price by the pool
+const maxSimulatedBundles = 32
+
+var (
+	bundleCommittedMeter = metrics.NewRegisteredMeter("worker/bundles/committed", nil)
+	bundleFailedMeter    = metrics.NewRegisteredMeter("worker/bundles/failed", nil)
+	bundleOutbidMeter    = metrics.NewRegisteredMeter("worker/bundles/outbid", nil)
+	bundleSkippedMeter   = metrics.NewRegisteredMeter("worker/bundles/skipped", nil)
+)
+
+// simulatedBundle is a bundle executed against the pending state
+type simulatedBundle struct {
+	bundle  *core.Bundle
+	gasUsed uint64
+	profit  *big.Int // Increase of the coinbase balance
+}
+
+// price returns the profit of the bundle per unit of gas
+func (b *simulatedBundle) price() *big.Int {
+	if b.gasUsed == 0 {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Div(b.profit, new(big.Int).SetUint64(b.gasUsed))
+}
+
+// applyBundle executes all the transactions of the bundle on top of env. If any
+// of them can't be included, or reverts without being allowed to, the env is
+// left untouched and an error is returned. Otherwise the returned function
+// reverts the env to its state before the bundle.
+//
+// The state is restored from a copy, since applying a transaction finalises the
+// state and invalidates the snapshots taken before it.
+func (w *worker) applyBundle(env *environment, bundle *core.Bundle, interruptCtx context.Context) (*simulatedBundle, func(), error) {
+	var (
+		state    = env.state.Copy()
+		txs      = len(env.txs)
+		txIO     = len(env.mvReadMapList)
+		tcount   = env.tcount
+		gas      = env.gasPool.Gas()
+		gasUsed  = env.header.GasUsed
+		balance  = env.state.GetBalance(env.coinbase)
+		revertFn = func() {
+			env.state = state
+			env.txs = env.txs[:txs]
+			env.receipts = env.receipts[:txs]
+			env.tcount = tcount
+			*env.gasPool = core.GasPool(gas)
+			env.header.GasUsed = gasUsed
+
+			env.depsMVFullWriteList = env.depsMVFullWriteList[:txIO]
+			env.mvReadMapList = env.mvReadMapList[:txIO]
+			for i := range env.deps {
+				if i >= txIO {
+					delete(env.deps, i)
+				}
+			}
+		}
+		// Record the reads and writes of the transactions like commitTransactions,
+		// for the dependencies declared in the header to cover the bundles
+		recordTxIO = w.chainConfig.Bor != nil && w.chainConfig.Bor.IsParallelUniverse(env.header.Number)
+	)
+
+	for _, tx := range bundle.Txs {
+		if tx.Protected() && !w.chainConfig.IsEIP155(env.header.Number) {
+			revertFn()
+			return nil, nil, fmt.Errorf("transaction %s is replay protected before EIP-155", tx.Hash())
+		}
+
+		env.state.Prepare(tx.Hash(), env.tcount)
+
+		if recordTxIO {
+			env.state.AddEmptyMVHashMap()
+		}
+
+		_, err := w.commitTransaction(env, tx, interruptCtx)
+		if err != nil {
+			if recordTxIO {
+				env.state.ClearReadMap()
+				env.state.ClearWriteMap()
+			}
+
+			revertFn()
+
+			return nil, nil, fmt.Errorf("transaction %s: %w", tx.Hash(), err)
+		}
+
+		env.tcount++
+
+		if recordTxIO {
+			env.addTxIO(env.state.MVReadList(), env.state.MVFullWriteList())
+			env.state.ClearReadMap()
+			env.state.ClearWriteMap()
+		}
+
+		if receipt := env.receipts[len(env.receipts)-1]; receipt.Status == types.ReceiptStatusFailed && !bundle.CanRevert(tx.Hash()) {
+			revertFn()
+			return nil, nil, fmt.Errorf("transaction %s reverted", tx.Hash())
+		}
+	}
+
+	result := &simulatedBundle{
+		bundle:  bundle,
+		gasUsed: env.header.GasUsed - gasUsed,
+		profit:  new(big.Int).Sub(env.state.GetBalance(env.coinbase), balance),
+	}
+
+	return result, revertFn, nil
+}
+
+// simulateBundles executes the first maxSimulatedBundles bundles against env one
+// by one, and returns the successful ones sorted by decreasing profit per unit
+// of gas. The env is left untouched.
+func (w *worker) simulateBundles(env *environment, bundles []*core.Bundle, interruptCtx context.Context) []*simulatedBundle {
+	if len(bundles) > maxSimulatedBundles {
+		bundleSkippedMeter.Mark(int64(len(bundles) - maxSimulatedBundles))
+		bundles = bundles[:maxSimulatedBundles]
+	}
+
+	simulated := make([]*simulatedBundle, 0, len(bundles))
+
+	for _, bundle := range bundles {
+		result, revertFn, err := w.applyBundle(env, bundle, interruptCtx)
+		if err != nil {
+			log.Debug("Bundle simulation failed", "hash", bundle.Hash(), "err", err)
+			bundleFailedMeter.Mark(1)
+
+			continue
+		}
+
+		revertFn()
+
+		simulated = append(simulated, result)
+	}
+
+	sort.SliceStable(simulated, func(i, j int) bool {
+		return simulated[i].price().Cmp(simulated[j].price()) > 0
+	})
+
+	return simulated
+}
+
+// commitBundles includes the most profitable bundles at the top of the block,
+// each one only if it pays more per unit of gas than the ordinary transactions
+// it pushes out of the block. A bundle is re-executed on top of the previous
+// ones, and dropped if it fails or isn't as profitable anymore.
+func (w *worker) commitBundles(env *environment, bundles []*core.Bundle, tips ordinaryTips, interruptCtx context.Context) {
+	if env.gasPool == nil {
+		env.gasPool = new(core.GasPool).AddGas(env.header.GasLimit)
+	}
+
+	for _, simulated := range w.simulateBundles(env, bundles, interruptCtx) {
+		if interruptCtx != nil && interruptCtx.Err() != nil {
+			break
+		}
+
+		if simulated.gasUsed > env.gasPool.Gas() {
+			continue
+		}
+
+		if simulated.price().Cmp(tips.displaced(env.gasPool.Gas()-simulated.gasUsed)) < 0 {
+			bundleOutbidMeter.Mark(1)
+			continue
+		}
+
+		hash := simulated.bundle.Hash()
+
+		result, revertFn, err := w.applyBundle(env, simulated.bundle, interruptCtx)
+		if err != nil {
+			log.Debug("Failed to commit bundle", "hash", hash, "err", err)
+			bundleFailedMeter.Mark(1)
+
+			continue
+		}
+
+		if result.price().Cmp(tips.displaced(env.gasPool.Gas())) < 0 {
+			revertFn()
+			bundleOutbidMeter.Mark(1)
+
+			continue
+		}
+
+		bundleCommittedMeter.Mark(1)
+		log.Debug("Committed bundle", "hash", hash, "txs", len(simulated.bundle.Txs), "gas", result.gasUsed, "profit", result.profit)
+	}
+
+	w.updateTxDependency(env)
+}
+
+// ordinaryTip is the priority fee paid by an ordinary pending transaction
+type ordinaryTip struct {
+	tip *big.Int
+	gas uint64
+}
+
+// ordinaryTips are the priority fees paid by the ordinary pending transactions,
+// sorted by decreasing tip. They estimate what the block space taken by bundles
+// would have earned otherwise.
+type ordinaryTips []ordinaryTip
+
+func newOrdinaryTips(baseFee *big.Int, pending ...map[common.Address]types.Transactions) ordinaryTips {
+	var tips ordinaryTips
+
+	for _, txsByAccount := range pending {
+		for _, txs := range txsByAccount {
+			for _, tx := range txs {
+				tip, err := tx.EffectiveGasTip(baseFee)
+				if err == nil && tip.Sign() > 0 {
+					tips = append(tips, ordinaryTip{tip: tip, gas: tx.Gas()})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(tips, func(i, j int) bool {
+		return tips[i].tip.Cmp(tips[j].tip) > 0
+	})
+
+	return tips
+}
+
+// displaced returns the tip of the best ordinary transaction which doesn't fit
+// in the given gas anymore, which is the minimum profit per unit of gas a bundle
+// leaving only that gas must pay. It's zero if all of them fit.
+func (t ordinaryTips) displaced(gas uint64) *big.Int {
+	for _, tip := range t {
+		if tip.gas > gas {
+			return tip.tip
+		}
+
+		gas -= tip.gas
+	}
+
+	return new(big.Int)
+}
@@ -0,0 +1,169 @@
+package miner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	cmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestOrdinaryTipsDisplaced(t *testing.T) {
+	t.Parallel()
+
+	key, _ := crypto.GenerateKey()
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+
+	newTx := func(nonce uint64, gas uint64, tip int64) *types.Transaction {
+		return types.MustSignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     nonce,
+			Gas:       gas,
+			GasTipCap: big.NewInt(tip),
+			GasFeeCap: big.NewInt(100 + tip),
+		})
+	}
+
+	pending := map[common.Address]types.Transactions{
+		{0x1}: {newTx(0, 50000, 3), newTx(1, 30000, 1)},
+		{0x2}: {newTx(0, 20000, 2)},
+	}
+
+	// tips of 3, 2 and 1 for 50000, 20000 and 30000 gas
+	tips := newOrdinaryTips(big.NewInt(100), pending)
+	if len(tips) != 3 {
+		t.Fatalf("tips count mismatch: have %d, want 3", len(tips))
+	}
+
+	tests := []struct {
+		gas  uint64
+		want int64
+	}{
+		{100000, 0},
+		{99999, 1},
+		{70000, 1},
+		{69999, 2},
+		{49999, 3},
+	}
+	for _, tt := range tests {
+		if have := tips.displaced(tt.gas); have.Int64() != tt.want {
+			t.Errorf("displaced tip mismatch for %d gas: have %v, want %d", tt.gas, have, tt.want)
+		}
+	}
+
+	// transactions underpaying the base fee aren't counted
+	if tips := newOrdinaryTips(big.NewInt(1000), pending); len(tips) != 0 {
+		t.Errorf("unexpected tips below the base fee: %d", len(tips))
+	}
+}
+
+// nolint : paralleltest
+func TestCommitBundlesTxDependency(t *testing.T) {
+	chainConfig := *params.BorUnittestChainConfig
+	borConfig := *chainConfig.Bor
+	borConfig.ParallelUniverseBlock = big.NewInt(0)
+	chainConfig.Bor = &borConfig
+	chainConfig.LondonBlock = big.NewInt(0)
+
+	engine, ctrl := getFakeBorFromConfig(t, &chainConfig)
+	defer ctrl.Finish()
+	defer engine.Close()
+
+	w, _, _ := NewTestWorker(t, &chainConfig, engine, rawdb.NewMemoryDatabase(), 0, 0, 0, 0)
+	defer w.close()
+
+	env, err := w.prepareWork(&generateParams{timestamp: uint64(time.Now().Unix()), coinbase: TestBankAddress})
+	if err != nil {
+		t.Fatalf("failed to prepare work: %v", err)
+	}
+
+	var (
+		signer   = types.LatestSigner(&chainConfig)
+		gasPrice = big.NewInt(10 * params.InitialBaseFee)
+		keys     = make([]*ecdsa.PrivateKey, 2)
+	)
+
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		env.state.AddBalance(crypto.PubkeyToAddress(keys[i].PublicKey), big.NewInt(params.Ether))
+	}
+
+	// Both senders send funds to the same account, the ordinary transaction
+	// depends on the bundle one. The failing bundle is dropped.
+	newTx := func(key *ecdsa.PrivateKey, nonce uint64) *types.Transaction {
+		return types.MustSignNewTx(key, signer, &types.LegacyTx{Nonce: nonce, To: &testUserAddress, Value: big.NewInt(1000), Gas: params.TxGas, GasPrice: gasPrice})
+	}
+	bundles := []*core.Bundle{
+		{Txs: types.Transactions{newTx(keys[0], 0)}},
+		{Txs: types.Transactions{newTx(keys[1], 1)}},
+	}
+
+	w.commitBundles(env, bundles, nil, context.Background())
+
+	if have, want := fmt.Sprint(env.header.TxDependency), "[[]]"; have != want {
+		t.Fatalf("dependencies after bundles: have %s, want %s", have, want)
+	}
+
+	txs := types.NewTransactionsByPriceAndNonce(env.signer, map[common.Address]types.Transactions{
+		crypto.PubkeyToAddress(keys[1].PublicKey): {newTx(keys[1], 0)},
+	}, cmath.FromBig(env.header.BaseFee))
+	w.commitTransactions(env, txs, nil, context.Background())
+
+	if env.tcount != 2 {
+		t.Fatalf("committed transactions: have %d, want 2", env.tcount)
+	}
+	if have, want := fmt.Sprint(env.header.TxDependency), "[[] [0]]"; have != want {
+		t.Fatalf("dependencies: have %s, want %s", have, want)
+	}
+}
+
+// nolint : paralleltest
+func TestSimulateBundlesLimit(t *testing.T) {
+	engine, ctrl := getFakeBorFromConfig(t, params.BorUnittestChainConfig)
+	defer ctrl.Finish()
+	defer engine.Close()
+
+	w, _, _ := NewTestWorker(t, params.BorUnittestChainConfig, engine, rawdb.NewMemoryDatabase(), 0, 0, 0, 0)
+	defer w.close()
+
+	env, err := w.prepareWork(&generateParams{timestamp: uint64(time.Now().Unix()), coinbase: TestBankAddress})
+	if err != nil {
+		t.Fatalf("failed to prepare work: %v", err)
+	}
+
+	env.gasPool = new(core.GasPool).AddGas(env.header.GasLimit)
+
+	signer := types.LatestSigner(params.BorUnittestChainConfig)
+	bundles := make([]*core.Bundle, maxSimulatedBundles+1)
+
+	for i := range bundles {
+		key, _ := crypto.GenerateKey()
+		env.state.AddBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(params.Ether))
+
+		tx := types.MustSignNewTx(key, signer, &types.LegacyTx{To: &testUserAddress, Value: big.NewInt(1000), Gas: params.TxGas, GasPrice: big.NewInt(10 * params.InitialBaseFee)})
+		bundles[i] = &core.Bundle{Txs: types.Transactions{tx}}
+	}
+
+	// Only the first bundles are simulated, and the env is left untouched
+	simulated := w.simulateBundles(env, bundles, context.Background())
+	if len(simulated) != maxSimulatedBundles {
+		t.Fatalf("simulated bundles: have %d, want %d", len(simulated), maxSimulatedBundles)
+	}
+	for _, result := range simulated {
+		if result.bundle == bundles[maxSimulatedBundles] {
+			t.Errorf("bundle past the limit simulated")
+		}
+	}
+	if env.tcount != 0 {
+		t.Errorf("committed transactions: have %d, want 0", env.tcount)
+	}
+}
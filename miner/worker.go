@@ -99,6 +99,9 @@ var (
 	sealedBlocksCounter      = metrics.NewRegisteredCounter("worker/sealedBlocks", nil)
 	sealedEmptyBlocksCounter = metrics.NewRegisteredCounter("worker/sealedEmptyBlocks", nil)
 	txCommitInterruptCounter = metrics.NewRegisteredCounter("worker/txCommitInterrupt", nil)
+	parallelTxsMeter         = metrics.NewRegisteredMeter("worker/txs/parallel", nil)
+	parallelFallbackCounter  = metrics.NewRegisteredCounter("worker/txs/parallelFallback", nil)
+	conflictDeferredMeter    = metrics.NewRegisteredMeter("worker/txs/conflictDeferred", nil)
 )
 
 // environment is the worker's current environment and holds all
@@ -117,6 +120,12 @@ type environment struct {
 	txs      []*types.Transaction
 	receipts []*types.Receipt
 	uncles   map[common.Hash]*types.Header
+
+	// reads and writes of the committed transactions, from which the dependencies
+	// declared in the header are computed
+	depsMVFullWriteList [][]blockstm.WriteDescriptor
+	mvReadMapList       []map[blockstm.Key]blockstm.ReadDescriptor
+	deps                map[int]map[int]bool
 }
 
 // copy creates a deep copy of environment.
@@ -143,9 +152,85 @@ func (env *environment) copy() *environment {
 	for hash, uncle := range env.uncles {
 		cpy.uncles[hash] = uncle
 	}
+	// The recorded reads, writes and dependencies of a transaction are never
+	// modified, only the lists need to be copied.
+	if env.mvReadMapList != nil {
+		cpy.depsMVFullWriteList = make([][]blockstm.WriteDescriptor, len(env.depsMVFullWriteList))
+		copy(cpy.depsMVFullWriteList, env.depsMVFullWriteList)
+		cpy.mvReadMapList = make([]map[blockstm.Key]blockstm.ReadDescriptor, len(env.mvReadMapList))
+		copy(cpy.mvReadMapList, env.mvReadMapList)
+		cpy.deps = make(map[int]map[int]bool, len(env.deps))
+		for i, deps := range env.deps {
+			cpy.deps[i] = deps
+		}
+	}
 	return cpy
 }
 
+// addTxIO records the reads and writes of the last committed transaction, and
+// computes its dependencies on the transactions committed before it.
+func (env *environment) addTxIO(readList []blockstm.ReadDescriptor, writeList []blockstm.WriteDescriptor) {
+	readMap := make(map[blockstm.Key]blockstm.ReadDescriptor, len(readList))
+	for _, read := range readList {
+		readMap[read.Path] = read
+	}
+
+	if env.deps == nil {
+		env.deps = map[int]map[int]bool{}
+	}
+
+	env.depsMVFullWriteList = append(env.depsMVFullWriteList, writeList)
+	env.mvReadMapList = append(env.mvReadMapList, readMap)
+
+	env.deps = blockstm.UpdateDeps(env.deps, blockstm.TxDep{
+		Index:         len(env.mvReadMapList) - 1,
+		ReadList:      readList,
+		FullWriteList: env.depsMVFullWriteList,
+	})
+}
+
+// txDependency returns the dependencies of all the committed transactions, to
+// be declared in the header. It's nil if the fees can't be delayed, because a
+// transaction reads the balance of the coinbase or of the burnt contract.
+func (env *environment) txDependency(burntContract common.Address) [][]uint64 {
+	if len(env.mvReadMapList) == 0 {
+		return nil
+	}
+
+	tempDeps := make([][]uint64, len(env.mvReadMapList))
+
+	for j := range env.deps[0] {
+		tempDeps[0] = append(tempDeps[0], uint64(j))
+	}
+
+	for i := 1; i <= len(env.mvReadMapList)-1; i++ {
+		reads := env.mvReadMapList[i-1]
+
+		_, ok1 := reads[blockstm.NewSubpathKey(env.coinbase, state.BalancePath)]
+		_, ok2 := reads[blockstm.NewSubpathKey(burntContract, state.BalancePath)]
+
+		if ok1 || ok2 {
+			return nil
+		}
+
+		for j := range env.deps[i] {
+			tempDeps[i] = append(tempDeps[i], uint64(j))
+		}
+	}
+
+	return tempDeps
+}
+
+// updateTxDependency declares in the header the dependencies of all the
+// transactions committed to the block so far.
+func (w *worker) updateTxDependency(env *environment) {
+	if w.chainConfig.Bor == nil || !w.chainConfig.Bor.IsParallelUniverse(env.header.Number) {
+		return
+	}
+
+	env.header.TxDependency = env.txDependency(common.HexToAddress(w.chainConfig.Bor.CalculateBurntContract(env.header.Number.Uint64())))
+}
+
 // unclelist returns the contained uncles as the list format.
 func (env *environment) unclelist() []*types.Header {
 	var uncles []*types.Header
@@ -974,21 +1059,13 @@ func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByP
 	}
 	var coalescedLogs []*types.Log
 
-	var depsMVReadList [][]blockstm.ReadDescriptor
-
-	var depsMVFullWriteList [][]blockstm.WriteDescriptor
-
-	var mvReadMapList []map[blockstm.Key]blockstm.ReadDescriptor
-
-	var deps map[int]map[int]bool
-
-	chDeps := make(chan blockstm.TxDep)
-
-	var count int
+	var EnableMVHashMap bool
 
-	var depsWg sync.WaitGroup
+	// number of transactions to execute one by one before trying to execute
+	// the next ones in parallel again
+	var serialTxs int
 
-	var EnableMVHashMap bool
+	parallelBuild := w.config.ParallelBuild && w.chainConfig.Bor != nil
 
 	if w.chainConfig.Bor.IsParallelUniverse(env.header.Number) {
 		EnableMVHashMap = true
@@ -996,31 +1073,6 @@ func (w *worker) commitTransactions(env *environment, txs *types.TransactionsByP
 		EnableMVHashMap = false
 	}
 
-	// create and add empty mvHashMap in statedb
-	if EnableMVHashMap {
-		depsMVReadList = [][]blockstm.ReadDescriptor{}
-
-		depsMVFullWriteList = [][]blockstm.WriteDescriptor{}
-
-		mvReadMapList = []map[blockstm.Key]blockstm.ReadDescriptor{}
-
-		deps = map[int]map[int]bool{}
-
-		chDeps = make(chan blockstm.TxDep)
-
-		count = 0
-
-		depsWg.Add(1)
-
-		go func(chDeps chan blockstm.TxDep) {
-			for t := range chDeps {
-				deps = blockstm.UpdateDeps(deps, t)
-			}
-
-			depsWg.Done()
-		}(chDeps)
-	}
-
 	initialGasLimit := env.gasPool.Gas()
 	initialTxs := txs.GetTxs()
 
@@ -1083,12 +1135,58 @@ mainloop:
 			breakCause = "Not enough gas for further transactions"
 			break
 		}
+		// Speculatively execute the next transactions in parallel, falling back to
+		// executing them one by one if any of them can't be committed
+		if parallelBuild && serialTxs == 0 {
+			if batch := w.nextParallelBatch(env, txs); len(batch) > 1 {
+				result, err := w.commitTransactionsParallel(env, batch, interruptCtx)
+				if err == nil {
+					coalescedLogs = append(coalescedLogs, result.Logs...)
+
+					for i := range batch {
+						env.tcount++
+
+						if EnableMVHashMap {
+							env.addTxIO(result.TxIO.ReadSet(i), result.TxIO.AllWriteSet(i))
+						}
+
+						w.eth.TxPool().SetConflictHints(batch[i].Hash(), touchedContracts(batch[i], result.Receipts[i].Logs))
+
+						from, _ := types.Sender(env.signer, batch[i])
+						txs.ShiftSender(from)
+					}
+
+					parallelTxsMeter.Mark(int64(len(batch)))
+
+					continue
+				}
+
+				if interruptCtx != nil && interruptCtx.Err() != nil {
+					txCommitInterruptCounter.Inc(1)
+					log.Warn("Tx Level Interrupt")
+
+					breakCause = "interrupt"
+
+					break mainloop
+				}
+
+				log.Debug("Failed to commit transactions in parallel, committing them one by one", "count", len(batch), "err", err)
+				parallelFallbackCounter.Inc(1)
+
+				serialTxs = len(batch)
+			}
+		}
+
 		// Retrieve the next transaction and abort if all done
 		tx := txs.Peek()
 		if tx == nil {
 			breakCause = "all transactions has been included"
 			break
 		}
+
+		if serialTxs > 0 {
+			serialTxs--
+		}
 		// Error may be ignored here. The error has already been checked
 		// during transaction acceptance is the transaction pool.
 		//
@@ -1134,19 +1232,10 @@ mainloop:
 			coalescedLogs = append(coalescedLogs, logs...)
 			env.tcount++
 
-			if EnableMVHashMap {
-				depsMVReadList = append(depsMVReadList, env.state.MVReadList())
-				depsMVFullWriteList = append(depsMVFullWriteList, env.state.MVFullWriteList())
-				mvReadMapList = append(mvReadMapList, env.state.MVReadMap())
-
-				temp := blockstm.TxDep{
-					Index:         env.tcount - 1,
-					ReadList:      depsMVReadList[count],
-					FullWriteList: depsMVFullWriteList,
-				}
+			w.eth.TxPool().SetConflictHints(tx.Hash(), touchedContracts(tx, logs))
 
-				chDeps <- temp
-				count++
+			if EnableMVHashMap {
+				env.addTxIO(env.state.MVReadList(), env.state.MVFullWriteList())
 			}
 
 			txs.Shift()
@@ -1173,43 +1262,10 @@ mainloop:
 		}
 	}
 
-	// nolint:nestif
+	// The dependencies cover the transactions committed by the previous calls
+	// for the same block too
 	if EnableMVHashMap {
-		close(chDeps)
-		depsWg.Wait()
-
-		if len(mvReadMapList) > 0 {
-			tempDeps := make([][]uint64, len(mvReadMapList))
-
-			for j := range deps[0] {
-				tempDeps[0] = append(tempDeps[0], uint64(j))
-			}
-
-			delayFlag := true
-
-			for i := 1; i <= len(mvReadMapList)-1; i++ {
-				reads := mvReadMapList[i-1]
-
-				_, ok1 := reads[blockstm.NewSubpathKey(env.coinbase, state.BalancePath)]
-				_, ok2 := reads[blockstm.NewSubpathKey(common.HexToAddress(w.chainConfig.Bor.CalculateBurntContract(env.header.Number.Uint64())), state.BalancePath)]
-
-				if ok1 || ok2 {
-					delayFlag = false
-				}
-
-				for j := range deps[i] {
-					tempDeps[i] = append(tempDeps[i], uint64(j))
-				}
-			}
-
-			if delayFlag {
-				env.header.TxDependency = tempDeps
-			} else {
-				env.header.TxDependency = nil
-			}
-		} else {
-			env.header.TxDependency = nil
-		}
+		w.updateTxDependency(env)
 	}
 
 	if !w.isRunning() && len(coalescedLogs) > 0 {
@@ -1235,6 +1291,150 @@ mainloop:
 	return false
 }
 
+// parallelBatchSize is the maximum number of transactions executed at once when
+// building blocks in parallel
+const parallelBatchSize = 64
+
+// nextParallelBatch returns the next transactions to commit, without removing
+// them from txs. The transactions which are expected to conflict with the batch
+// according to the pool hints are left to the next batches, along with the next
+// transactions of their accounts. The batch stops at the first transaction which
+// doesn't fit in the remaining gas.
+func (w *worker) nextParallelBatch(env *environment, txs *types.TransactionsByPriceAndNonce) types.Transactions {
+	var (
+		batch      types.Transactions
+		gas        uint64
+		candidates = txs.Copy()
+		touched    = make(map[common.Address]struct{})
+		deferred   int
+	)
+
+	for len(batch) < parallelBatchSize {
+		tx := candidates.Peek()
+		if tx == nil {
+			break
+		}
+
+		// replay protected transactions are skipped one by one
+		if tx.Protected() && !w.chainConfig.IsEIP155(env.header.Number) {
+			break
+		}
+
+		if gas+tx.Gas() > env.gasPool.Gas() {
+			break
+		}
+
+		hints := w.eth.TxPool().ConflictHints(tx.Hash())
+
+		if conflicts(touched, hints) && deferred < parallelBatchSize {
+			deferred++
+
+			candidates.Pop()
+
+			continue
+		}
+
+		for _, contract := range hints {
+			touched[contract] = struct{}{}
+		}
+
+		gas += tx.Gas()
+		batch = append(batch, tx)
+
+		candidates.Shift()
+	}
+
+	conflictDeferredMeter.Mark(int64(deferred))
+
+	return batch
+}
+
+// conflicts returns true if any of the contracts was touched
+func conflicts(touched map[common.Address]struct{}, contracts []common.Address) bool {
+	for _, contract := range contracts {
+		if _, ok := touched[contract]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// touchedContracts returns the contracts touched by an executed transaction, as
+// far as they are cheaply known: its recipient and the emitters of its logs.
+func touchedContracts(tx *types.Transaction, logs []*types.Log) []common.Address {
+	var contracts []common.Address
+
+	seen := make(map[common.Address]struct{})
+
+	add := func(contract common.Address) {
+		if _, ok := seen[contract]; !ok {
+			seen[contract] = struct{}{}
+			contracts = append(contracts, contract)
+		}
+	}
+
+	if to := tx.To(); to != nil {
+		add(*to)
+	}
+
+	for _, l := range logs {
+		add(l.Address)
+	}
+
+	return contracts
+}
+
+// commitTransactionsParallel executes the batch of transactions in parallel with
+// block-stm, and commits them to the environment if all of them could be applied.
+// The environment is left untouched otherwise.
+func (w *worker) commitTransactionsParallel(env *environment, batch types.Transactions, interruptCtx context.Context) (*core.ParallelApplyResult, error) {
+	result, err := core.ApplyTransactionsParallel(w.chainConfig, w.chain, &env.coinbase, env.header, env.state, batch, env.tcount, env.header.GasUsed, *w.chain.GetVMConfig(), interruptCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := env.gasPool.SubGas(result.UsedGas - env.header.GasUsed); err != nil {
+		return nil, err
+	}
+
+	// keep tracking the reads and writes of the next transactions, if needed
+	result.State.SetMVHashmap(env.state.GetMVHashmap())
+
+	env.state = result.State
+	env.header.GasUsed = result.UsedGas
+	env.txs = append(env.txs, batch...)
+	env.receipts = append(env.receipts, result.Receipts...)
+
+	return result, nil
+}
+
+// privateTransactions returns the private transactions of the pool which can be
+// included in the block, provided the local signer is its primary producer.
+func (w *worker) privateTransactions(env *environment) map[common.Address]types.Transactions {
+	borEngine, ok := w.engine.(*bor.Bor)
+	if !ok {
+		return nil
+	}
+
+	pending := w.eth.TxPool().PendingPrivate(env.header.Number.Uint64())
+	if len(pending) == 0 {
+		return nil
+	}
+
+	primary, err := borEngine.IsPrimaryProducer(w.chain, env.header)
+	if err != nil {
+		log.Debug("Failed to check the primary producer, skipping private transactions", "number", env.header.Number, "err", err)
+		return nil
+	}
+
+	if !primary {
+		return nil
+	}
+
+	return pending
+}
+
 // generateParams wraps various of settings for generating sealing task.
 type generateParams struct {
 	timestamp  uint64         // The timstamp for sealing task
@@ -1513,6 +1713,35 @@ func (w *worker) fillTransactions(ctx context.Context, interrupt *int32, env *en
 		committed       bool
 	)
 
+	// Bundles land atomically at the top of the block, provided they pay more
+	// than the ordinary transactions they push out
+	if bundles := w.eth.TxPool().PendingBundles(env.header.Number.Uint64(), env.header.Time); len(bundles) > 0 {
+		tracing.Exec(ctx, "", "worker.CommitBundles", func(ctx context.Context, span trace.Span) {
+			tips := newOrdinaryTips(env.header.BaseFee, localTxs, remoteTxs)
+			w.commitBundles(env, bundles, tips, interruptCtx)
+
+			tracing.SetAttributes(
+				span,
+				attribute.Int("len of bundles", len(bundles)),
+				attribute.Int("len of txs after bundles", env.tcount),
+			)
+		})
+	}
+
+	// Private transactions go first, they were sent to this node to be included
+	// as soon as possible
+	if privateTxs := w.privateTransactions(env); len(privateTxs) > 0 {
+		txs := types.NewTransactionsByPriceAndNonce(env.signer, privateTxs, cmath.FromBig(env.header.BaseFee))
+
+		tracing.Exec(ctx, "", "worker.PrivateCommitTransactions", func(ctx context.Context, span trace.Span) {
+			committed = w.commitTransactions(env, txs, interrupt, interruptCtx)
+		})
+
+		if committed {
+			return
+		}
+	}
+
 	if localTxsCount > 0 {
 		var txs *types.TransactionsByPriceAndNonce
 
@@ -17,6 +17,9 @@
 package miner
 
 import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
 	"math/big"
 	"os"
 	"sync/atomic"
@@ -37,6 +40,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/tests/bor/mocks"
@@ -57,6 +61,160 @@ func TestGenerateBlockAndImportBor(t *testing.T) {
 	testGenerateBlockAndImport(t, false, true)
 }
 
+// nolint : paralleltest
+func TestGenerateBlockAndImportBorParallel(t *testing.T) {
+	chainConfig := params.BorUnittestChainConfig
+	log.Root().SetHandler(log.LvlFilterHandler(log.LvlDebug, log.StreamHandler(os.Stderr, log.TerminalFormat(false))))
+
+	engine, ctrl := getFakeBorFromConfig(t, chainConfig)
+	defer ctrl.Finish()
+	defer engine.Close()
+
+	chainConfig.LondonBlock = big.NewInt(0)
+
+	w, b, _ := NewTestWorker(t, chainConfig, engine, rawdb.NewMemoryDatabase(), 0, 0, 0, 0)
+	defer w.close()
+
+	config := *w.config
+	config.ParallelBuild = true
+	w.config = &config
+
+	// This test chain imports the mined blocks sequentially.
+	db2 := rawdb.NewMemoryDatabase()
+	b.Genesis.MustCommit(db2)
+
+	chain, _ := core.NewBlockChain(db2, nil, b.chain.Config(), engine, vm.Config{}, nil, nil, nil)
+	defer chain.Stop()
+
+	// Ignore empty commit here for less noise.
+	w.skipSealHook = func(task *task) bool {
+		return len(task.receipts) == 0
+	}
+
+	// Wait for mined blocks.
+	sub := w.mux.Subscribe(core.NewMinedBlockEvent{})
+	defer sub.Unsubscribe()
+
+	// Start mining!
+	w.start()
+
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 4; j++ {
+			if err := b.txPool.AddLocal(b.newRandomTx(j%2 == 0)); err != nil {
+				t.Fatal("while adding a local transaction", err)
+			}
+		}
+
+		select {
+		case ev := <-sub.Chan():
+			block := ev.Data.(core.NewMinedBlockEvent).Block
+			if _, err := chain.InsertChain([]*types.Block{block}); err != nil {
+				t.Fatalf("failed to insert new mined block %d: %v", block.NumberU64(), err)
+			}
+		case <-time.After(3 * time.Second): // Worker needs 1s to include new changes.
+			t.Fatalf("timeout")
+		}
+	}
+}
+
+// TestGenerateBlockTxDependency tests that the dependencies declared by a mined
+// block cover the transactions of all the passes filling it, so that the block
+// is accepted once they must match its execution.
+// nolint : paralleltest
+func TestGenerateBlockTxDependency(t *testing.T) {
+	chainConfig := *params.BorUnittestChainConfig
+	borConfig := *chainConfig.Bor
+	borConfig.ParallelUniverseBlock = big.NewInt(0)
+	borConfig.StrictTxDependencyBlock = big.NewInt(0)
+	chainConfig.Bor = &borConfig
+	chainConfig.LondonBlock = big.NewInt(0)
+
+	engine, ctrl := getFakeBorFromConfig(t, &chainConfig)
+	defer ctrl.Finish()
+	defer engine.Close()
+
+	w, b, _ := NewTestWorker(t, &chainConfig, engine, rawdb.NewMemoryDatabase(), 0, 0, 0, 0)
+	defer w.close()
+
+	// This test chain imports the mined blocks sequentially.
+	db2 := rawdb.NewMemoryDatabase()
+	b.Genesis.MustCommit(db2)
+
+	chain, _ := core.NewBlockChain(db2, nil, b.chain.Config(), engine, vm.Config{}, nil, nil, nil)
+	defer chain.Stop()
+
+	// Two senders are funded by the coinbase, then both of them send funds to the
+	// same account, as a local and a remote transaction. The remote transaction
+	// depends on the local one, committed before it in another pass.
+	var (
+		localKey, _  = crypto.GenerateKey()
+		remoteKey, _ = crypto.GenerateKey()
+		signer       = types.LatestSigner(&chainConfig)
+		gasPrice     = big.NewInt(10 * params.InitialBaseFee)
+		nonce        = b.txPool.Nonce(TestBankAddress)
+		funds        []*types.Transaction
+	)
+
+	for i, key := range []*ecdsa.PrivateKey{localKey, remoteKey} {
+		tx, _ := types.SignTx(types.NewTransaction(nonce+uint64(i), crypto.PubkeyToAddress(key.PublicKey), big.NewInt(params.Ether), params.TxGas, gasPrice, nil), signer, testBankKey)
+		if err := b.txPool.AddLocal(tx); err != nil {
+			t.Fatal("while adding a local transaction", err)
+		}
+
+		funds = append(funds, tx)
+	}
+
+	local, _ := types.SignTx(types.NewTransaction(0, testUserAddress, big.NewInt(1000), params.TxGas, gasPrice, nil), signer, localKey)
+	remote, _ := types.SignTx(types.NewTransaction(0, testUserAddress, big.NewInt(1000), params.TxGas, gasPrice, nil), signer, remoteKey)
+
+	sub := w.mux.Subscribe(core.NewMinedBlockEvent{})
+	defer sub.Unsubscribe()
+
+	w.start()
+
+	for funded := false; ; {
+		select {
+		case ev := <-sub.Chan():
+			block := ev.Data.(core.NewMinedBlockEvent).Block
+			if _, err := chain.InsertChain([]*types.Block{block}); err != nil {
+				t.Fatalf("failed to insert new mined block %d: %v", block.NumberU64(), err)
+			}
+
+			if block.Transaction(remote.Hash()) != nil {
+				if have, want := fmt.Sprint(block.TxDependency()), "[[] [0]]"; have != want {
+					t.Fatalf("dependencies: have %s, want %s", have, want)
+				}
+
+				return
+			}
+
+			if funded || chain.GetTransactionLookup(funds[0].Hash()) == nil || chain.GetTransactionLookup(funds[1].Hash()) == nil {
+				continue
+			}
+
+			funded = true
+
+			// Wait for the pool to see the funds of the senders
+			for start := time.Now(); ; time.Sleep(10 * time.Millisecond) {
+				err := b.txPool.AddRemoteSync(remote)
+				if err == nil {
+					break
+				}
+
+				if !errors.Is(err, core.ErrInsufficientFunds) || time.Since(start) > time.Second {
+					t.Fatal("while adding a remote transaction", err)
+				}
+			}
+
+			if err := b.txPool.AddLocal(local); err != nil {
+				t.Fatal("while adding a local transaction", err)
+			}
+		case <-time.After(3 * time.Second): // Worker needs 1s to include new changes.
+			t.Fatalf("timeout")
+		}
+	}
+}
+
 //nolint:thelper
 func testGenerateBlockAndImport(t *testing.T, isClique bool, isBor bool) {
 	var (
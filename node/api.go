@@ -18,6 +18,7 @@ package node
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -210,6 +211,7 @@ func (api *privateAdminAPI) StartHTTP(host *string, port *int, cors *string, api
 		CorsAllowedOrigins: api.node.config.HTTPCors,
 		Vhosts:             api.node.config.HTTPVirtualHosts,
 		Modules:            api.node.config.HTTPModules,
+		quota:              api.node.rpcQuota,
 	}
 	if cors != nil {
 		config.CorsAllowedOrigins = nil
@@ -283,6 +285,7 @@ func (api *privateAdminAPI) StartWS(host *string, port *int, allowedOrigins *str
 	config := wsConfig{
 		Modules: api.node.config.WSModules,
 		Origins: api.node.config.WSOrigins,
+		quota:   api.node.rpcQuota,
 		// ExposeAll: api.node.config.WSExposeAll,
 	}
 	if apis != nil {
@@ -416,25 +419,25 @@ func (api *privateAdminAPI) GetExecutionPoolRequestTimeout() *ExecutionPoolReque
 	return executionPoolRequestTimeout
 }
 
-// func (api *privateAdminAPI) SetWSExecutionPoolRequestTimeout(n int) *ExecutionPoolRequestTimeout {
-// 	if api.node.ws.host != "" {
-// 		api.node.ws.wsConfig.executionPoolRequestTimeout = time.Duration(n) * time.Millisecond
-// 		api.node.ws.wsHandler.Load().(*rpcHandler).server.SetExecutionPoolRequestTimeout(time.Duration(n) * time.Millisecond)
-// 		log.Warn("updating ws execution pool request timeout", "timeout", n)
-// 	}
+func (api *privateAdminAPI) SetWSExecutionPoolRequestTimeout(n int) *ExecutionPoolRequestTimeout {
+	if api.node.ws.host != "" {
+		api.node.ws.wsConfig.executionPoolRequestTimeout = time.Duration(n) * time.Millisecond
+		api.node.ws.wsHandler.Load().(*rpcHandler).server.SetExecutionPoolRequestTimeout(time.Duration(n) * time.Millisecond)
+		log.Warn("updating ws execution pool request timeout", "timeout", n)
+	}
 
-// 	return api.GetExecutionPoolRequestTimeout()
-// }
+	return api.GetExecutionPoolRequestTimeout()
+}
 
-// func (api *privateAdminAPI) SetHttpExecutionPoolRequestTimeout(n int) *ExecutionPoolRequestTimeout {
-// 	if api.node.http.host != "" {
-// 		api.node.http.httpConfig.executionPoolRequestTimeout = time.Duration(n) * time.Millisecond
-// 		api.node.http.httpHandler.Load().(*rpcHandler).server.SetExecutionPoolRequestTimeout(time.Duration(n) * time.Millisecond)
-// 		log.Warn("updating http execution pool request timeout", "timeout", n)
-// 	}
+func (api *privateAdminAPI) SetHttpExecutionPoolRequestTimeout(n int) *ExecutionPoolRequestTimeout {
+	if api.node.http.host != "" {
+		api.node.http.httpConfig.executionPoolRequestTimeout = time.Duration(n) * time.Millisecond
+		api.node.http.httpHandler.Load().(*rpcHandler).server.SetExecutionPoolRequestTimeout(time.Duration(n) * time.Millisecond)
+		log.Warn("updating http execution pool request timeout", "timeout", n)
+	}
 
-// 	return api.GetExecutionPoolRequestTimeout()
-// }
+	return api.GetExecutionPoolRequestTimeout()
+}
 
 func (api *privateAdminAPI) SetWSExecutionPoolSize(n int) *ExecutionPoolSize {
 	if api.node.ws.host != "" {
@@ -455,3 +458,111 @@ func (api *privateAdminAPI) SetHttpExecutionPoolSize(n int) *ExecutionPoolSize {
 
 	return api.GetExecutionPoolSize()
 }
+
+// SetHttpModules changes the modules exposed over HTTP, keeping the server running.
+func (api *privateAdminAPI) SetHttpModules(modules []string) ([]string, error) {
+	open, _ := api.node.GetAPIs()
+	if err := api.node.http.setModules(open, modules, false); err != nil {
+		return nil, fmt.Errorf("failed to set http modules: %w", err)
+	}
+	log.Warn("updating http modules", "modules", modules)
+
+	return modules, nil
+}
+
+// SetWSModules changes the modules exposed over WebSocket, keeping the server
+// running and the existing subscriptions.
+func (api *privateAdminAPI) SetWSModules(modules []string) ([]string, error) {
+	server := api.node.wsServerForPort(api.node.config.WSPort, false)
+	if err := server.setModules(api.node.rpcAPIs, modules, true); err != nil {
+		return nil, fmt.Errorf("failed to set ws modules: %w", err)
+	}
+	log.Warn("updating ws modules", "modules", modules)
+
+	return modules, nil
+}
+
+// SetHttpCorsAndVhosts changes the allowed CORS origins and virtual hosts of the HTTP
+// endpoint, keeping the server running.
+func (api *privateAdminAPI) SetHttpCorsAndVhosts(cors []string, vhosts []string) (bool, error) {
+	if err := api.node.http.setCorsAndVhosts(cors, vhosts); err != nil {
+		return false, fmt.Errorf("failed to set http cors: %w", err)
+	}
+	log.Warn("updating http cors and vhosts", "cors", cors, "vhosts", vhosts)
+
+	return true, nil
+}
+
+// RPCRateLimits are the rate limits of the HTTP and WebSocket endpoints.
+type RPCRateLimits struct {
+	Http *rpc.RateLimits `json:"http"`
+	WS   *rpc.RateLimits `json:"ws"`
+}
+
+func (api *privateAdminAPI) GetRateLimits() *RPCRateLimits {
+	var limits RPCRateLimits
+	if srv := api.node.http.rpcServer(false); srv != nil {
+		httpLimits := srv.GetRateLimits()
+		limits.Http = &httpLimits
+	}
+	if srv := api.node.wsServerForPort(api.node.config.WSPort, false).rpcServer(true); srv != nil {
+		wsLimits := srv.GetRateLimits()
+		limits.WS = &wsLimits
+	}
+
+	return &limits
+}
+
+// SetMethodRateLimit sets the rate limit, in calls per second, of an RPC method
+// over the given transport ("http" or "ws"). A zero rate removes the limit.
+func (api *privateAdminAPI) SetMethodRateLimit(transport string, method string, limit float64, burst int) (*RPCRateLimits, error) {
+	srv, err := api.rateLimitedServer(transport)
+	if err != nil {
+		return nil, err
+	}
+	srv.SetMethodRateLimit(method, rpc.RateLimit{Rate: limit, Burst: burst})
+	log.Warn("updating method rate limit", "transport", transport, "method", method, "rate", limit, "burst", burst)
+
+	return api.GetRateLimits(), nil
+}
+
+// SetIPRateLimit sets the rate limit, in calls per second, of each client IP
+// over the given transport ("http" or "ws"). A zero rate removes the limit.
+func (api *privateAdminAPI) SetIPRateLimit(transport string, limit float64, burst int) (*RPCRateLimits, error) {
+	srv, err := api.rateLimitedServer(transport)
+	if err != nil {
+		return nil, err
+	}
+	srv.SetIPRateLimit(rpc.RateLimit{Rate: limit, Burst: burst})
+	log.Warn("updating ip rate limit", "transport", transport, "rate", limit, "burst", burst)
+
+	return api.GetRateLimits(), nil
+}
+
+func (api *privateAdminAPI) rateLimitedServer(transport string) (*rpc.Server, error) {
+	var srv *rpc.Server
+
+	switch transport {
+	case "http":
+		srv = api.node.http.rpcServer(false)
+	case "ws":
+		srv = api.node.wsServerForPort(api.node.config.WSPort, false).rpcServer(true)
+	default:
+		return nil, fmt.Errorf("unknown transport %q, expected http or ws", transport)
+	}
+	if srv == nil {
+		return nil, fmt.Errorf("%s transport not enabled", transport)
+	}
+
+	return srv, nil
+}
+
+// GetQuotaUsage returns the quota usage of the clients of the HTTP and WebSocket
+// endpoints seen recently, keyed by client IP or API key hash.
+func (api *privateAdminAPI) GetQuotaUsage() (map[string]rpc.QuotaUsage, error) {
+	if api.node.rpcQuota == nil {
+		return nil, errors.New("rpc quotas not enabled")
+	}
+
+	return api.node.rpcQuota.Usage(), nil
+}
@@ -64,6 +64,7 @@ type Node struct {
 	wsAuth        *httpServer //
 	ipc           *ipcServer  // Stores information about the ipc http server
 	inprocHandler *rpc.Server // In-process RPC request handler to process the API requests
+	rpcQuota      *rpc.Quota  // Quotas of the clients of the HTTP and WebSocket endpoints, if enabled
 
 	databases map[*closeTrackingDB]struct{} // All open databases
 }
@@ -411,6 +412,7 @@ func (n *Node) startRPC() error {
 			prefix:                      n.config.HTTPPathPrefix,
 			executionPoolSize:           n.config.HTTPJsonRPCExecutionPoolSize,
 			executionPoolRequestTimeout: n.config.HTTPJsonRPCExecutionPoolRequestTimeout,
+			quota:                       n.rpcQuota,
 		}); err != nil {
 			return err
 		}
@@ -429,6 +431,7 @@ func (n *Node) startRPC() error {
 			prefix:                      n.config.WSPathPrefix,
 			executionPoolSize:           n.config.WSJsonRPCExecutionPoolSize,
 			executionPoolRequestTimeout: n.config.WSJsonRPCExecutionPoolRequestTimeout,
+			quota:                       n.rpcQuota,
 		}); err != nil {
 			return err
 		}
@@ -593,6 +596,18 @@ func (n *Node) RegisterAPIs(apis []rpc.API) {
 	n.rpcAPIs = append(n.rpcAPIs, apis...)
 }
 
+// SetRPCQuota enables the cost based quotas of the clients of the HTTP and
+// WebSocket endpoints, shared between both.
+func (n *Node) SetRPCQuota(config rpc.QuotaConfig) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.state != initializingState {
+		panic("can't set RPC quota on running/stopped node")
+	}
+	n.rpcQuota = rpc.NewQuota(config)
+}
+
 // GetAPIs return two sets of APIs, both the ones that do not require
 // authentication, and the complete set
 func (n *Node) GetAPIs() (unauthenticated, all []rpc.API) {
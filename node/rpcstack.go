@@ -19,6 +19,7 @@ package node
 import (
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -41,8 +42,9 @@ type httpConfig struct {
 	Modules            []string
 	CorsAllowedOrigins []string
 	Vhosts             []string
-	prefix             string // path prefix on which to mount http handler
-	jwtSecret          []byte // optional JWT secret
+	prefix             string     // path prefix on which to mount http handler
+	jwtSecret          []byte     // optional JWT secret
+	quota              *rpc.Quota // optional quotas of the clients
 
 	// Execution pool config
 	executionPoolSize           uint64
@@ -53,8 +55,9 @@ type httpConfig struct {
 type wsConfig struct {
 	Origins   []string
 	Modules   []string
-	prefix    string // path prefix on which to mount ws handler
-	jwtSecret []byte // optional JWT secret
+	prefix    string     // path prefix on which to mount ws handler
+	jwtSecret []byte     // optional JWT secret
+	quota     *rpc.Quota // optional quotas of the clients
 
 	// Execution pool config
 	executionPoolSize           uint64
@@ -295,6 +298,9 @@ func (h *httpServer) enableRPC(apis []rpc.API, config httpConfig) error {
 	// Create RPC server and handler.
 	srv := rpc.NewServer(config.executionPoolSize, config.executionPoolRequestTimeout)
 	srv.SetRPCBatchLimit(h.RPCBatchLimit)
+	if config.quota != nil {
+		srv.SetQuota(config.quota)
+	}
 	if err := RegisterApis(apis, config.Modules, srv, false); err != nil {
 		return err
 	}
@@ -327,6 +333,9 @@ func (h *httpServer) enableWS(apis []rpc.API, config wsConfig) error {
 	// Create RPC server and handler.
 	srv := rpc.NewServer(config.executionPoolSize, config.executionPoolRequestTimeout)
 	srv.SetRPCBatchLimit(h.RPCBatchLimit)
+	if config.quota != nil {
+		srv.SetQuota(config.quota)
+	}
 	if err := RegisterApis(apis, config.Modules, srv, false); err != nil {
 		return err
 	}
@@ -360,6 +369,96 @@ func (h *httpServer) disableWS() bool {
 	return ws != nil
 }
 
+// rpcServer returns the RPC server behind the HTTP or the WebSocket handler, nil
+// if the transport isn't enabled.
+func (h *httpServer) rpcServer(ws bool) *rpc.Server {
+	handler := h.httpHandler.Load().(*rpcHandler)
+	if ws {
+		handler = h.wsHandler.Load().(*rpcHandler)
+	}
+	if handler == nil {
+		return nil
+	}
+	return handler.server
+}
+
+// setModules changes the modules exposed over HTTP or WebSocket. The running RPC
+// server is updated in place, so the existing connections and subscriptions are
+// kept, the calls to the disabled modules failing from then on.
+func (h *httpServer) setModules(apis []rpc.API, modules []string, ws bool) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	srv := h.rpcServer(ws)
+	if srv == nil {
+		return errors.New("transport not enabled")
+	}
+	if bad, available := checkModuleAvailability(modules, apis); len(bad) > 0 {
+		return fmt.Errorf("unavailable modules %v, available %v", bad, available)
+	}
+	current := h.httpConfig.Modules
+	if ws {
+		current = h.wsConfig.Modules
+	}
+	var (
+		before = exposedNamespaces(apis, current)
+		after  = exposedNamespaces(apis, modules)
+	)
+	for namespace := range before {
+		if !after[namespace] {
+			srv.UnregisterName(namespace)
+		}
+	}
+	for _, api := range apis {
+		if after[api.Namespace] && !before[api.Namespace] {
+			if err := srv.RegisterName(api.Namespace, api.Service); err != nil {
+				return err
+			}
+		}
+	}
+	if ws {
+		h.wsConfig.Modules = modules
+	} else {
+		h.httpConfig.Modules = modules
+	}
+	return nil
+}
+
+// setCorsAndVhosts changes the allowed CORS origins and virtual hosts of the HTTP
+// handler, keeping its RPC server.
+func (h *httpServer) setCorsAndVhosts(cors []string, vhosts []string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	srv := h.rpcServer(false)
+	if srv == nil {
+		return errors.New("transport not enabled")
+	}
+	h.httpConfig.CorsAllowedOrigins = cors
+	h.httpConfig.Vhosts = vhosts
+	h.httpHandler.Store(&rpcHandler{
+		Handler: NewHTTPHandlerStack(srv, cors, vhosts, h.httpConfig.jwtSecret),
+		server:  srv,
+	})
+	return nil
+}
+
+// exposedNamespaces returns the namespaces registered by RegisterApis for the
+// given modules.
+func exposedNamespaces(apis []rpc.API, modules []string) map[string]bool {
+	allowList := make(map[string]bool)
+	for _, module := range modules {
+		allowList[module] = true
+	}
+	namespaces := make(map[string]bool)
+	for _, api := range apis {
+		if allowList[api.Namespace] || (len(allowList) == 0 && api.Public) {
+			namespaces[api.Namespace] = true
+		}
+	}
+	return namespaces
+}
+
 // rpcAllowed returns true when JSON-RPC over HTTP is enabled.
 func (h *httpServer) rpcAllowed() bool {
 	return h.httpHandler.Load().(*rpcHandler) != nil
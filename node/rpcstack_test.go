@@ -18,6 +18,7 @@ package node
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -60,6 +61,61 @@ func TestVhosts(t *testing.T) {
 	assert.Equal(t, resp2.StatusCode, http.StatusForbidden)
 }
 
+// TestSetCorsAndVhosts makes sure the CORS origins and vhosts can be changed on
+// the running http server.
+func TestSetCorsAndVhosts(t *testing.T) {
+	srv := createAndStartServer(t, &httpConfig{CorsAllowedOrigins: []string{"test.com"}, Vhosts: []string{"test"}}, false, &wsConfig{})
+	defer srv.stop()
+	url := "http://" + srv.listenAddr()
+
+	assert.NoError(t, srv.setCorsAndVhosts([]string{"other.com"}, []string{"other"}))
+
+	resp := rpcRequest(t, url, "origin", "other.com", "host", "other")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "other.com", resp.Header.Get("Access-Control-Allow-Origin"))
+
+	resp2 := rpcRequest(t, url, "origin", "test.com", "host", "test")
+	assert.Equal(t, http.StatusForbidden, resp2.StatusCode)
+}
+
+type testModuleService struct{}
+
+func (testModuleService) Echo(s string) string { return s }
+
+// TestSetModules makes sure the modules can be enabled and disabled on the
+// running http server.
+func TestSetModules(t *testing.T) {
+	apis := []rpc.API{
+		{Namespace: "a", Service: testModuleService{}},
+		{Namespace: "b", Service: testModuleService{}},
+	}
+	srv := newHTTPServer(testlog.Logger(t, log.LvlDebug), rpc.DefaultHTTPTimeouts, 100)
+	assert.NoError(t, srv.enableRPC(apis, httpConfig{Modules: []string{"a"}}))
+	assert.NoError(t, srv.setListenAddr("localhost", 0))
+	assert.NoError(t, srv.start())
+	defer srv.stop()
+	url := "http://" + srv.listenAddr()
+
+	modules := func() map[string]string {
+		resp := rpcRequest(t, url)
+		defer resp.Body.Close()
+
+		var result struct{ Result map[string]string }
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		return result.Result
+	}
+	assert.Contains(t, modules(), "a")
+	assert.NotContains(t, modules(), "b")
+
+	assert.NoError(t, srv.setModules(apis, []string{"b"}, false))
+	assert.NotContains(t, modules(), "a")
+	assert.Contains(t, modules(), "b")
+	assert.Equal(t, []string{"b"}, srv.httpConfig.Modules)
+
+	assert.Error(t, srv.setModules(apis, []string{"c"}, false))
+	assert.Error(t, srv.setModules(apis, []string{"a"}, true))
+}
+
 type originTest struct {
 	spec    string
 	expOk   []string
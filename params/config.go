@@ -586,10 +586,11 @@ type BorConfig struct {
 	StateReceiverContract    string                 `json:"stateReceiverContract"`    // State receiver contract
 	OverrideStateSyncRecords map[string]int         `json:"overrideStateSyncRecords"` // override state records count
 	BlockAlloc               map[string]interface{} `json:"blockAlloc"`
-	BurntContract            map[string]string      `json:"burntContract"`         // governance contract where the token will be sent to and burnt in london fork
-	JaipurBlock              *big.Int               `json:"jaipurBlock"`           // Jaipur switch block (nil = no fork, 0 = already on jaipur)
-	DelhiBlock               *big.Int               `json:"delhiBlock"`            // Delhi switch block (nil = no fork, 0 = already on delhi)
-	ParallelUniverseBlock    *big.Int               `json:"parallelUniverseBlock"` // TODO: update all occurrence, change name and finalize number (hardfork for block-stm related changes)
+	BurntContract            map[string]string      `json:"burntContract"`           // governance contract where the token will be sent to and burnt in london fork
+	JaipurBlock              *big.Int               `json:"jaipurBlock"`             // Jaipur switch block (nil = no fork, 0 = already on jaipur)
+	DelhiBlock               *big.Int               `json:"delhiBlock"`              // Delhi switch block (nil = no fork, 0 = already on delhi)
+	ParallelUniverseBlock    *big.Int               `json:"parallelUniverseBlock"`   // TODO: update all occurrence, change name and finalize number (hardfork for block-stm related changes)
+	StrictTxDependencyBlock  *big.Int               `json:"strictTxDependencyBlock"` // Block from which headers with mismatching tx dependencies are rejected (nil = no fork)
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -630,6 +631,12 @@ func (c *BorConfig) IsParallelUniverse(number *big.Int) bool {
 	return isForked(c.ParallelUniverseBlock, number)
 }
 
+// IsStrictTxDependency returns whether the transaction dependencies declared in
+// the header must match the execution of the block.
+func (c *BorConfig) IsStrictTxDependency(number *big.Int) bool {
+	return isForked(c.StrictTxDependencyBlock, number)
+}
+
 func (c *BorConfig) IsSprintStart(number uint64) bool {
 	return number%c.CalculateSprint(number) == 0
 }
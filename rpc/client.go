@@ -76,6 +76,8 @@ type Client struct {
 	idgen    func() ID // for subscriptions
 	isHTTP   bool      // connection type: http, ws or ipc
 	services *serviceRegistry
+	limiter  *rateLimiter // rate limits of the served calls, nil for clients
+	quota    *Quota       // quotas of the served calls, nil if not enabled
 
 	idCounter uint32
 
@@ -112,7 +114,7 @@ func (c *Client) newClientConn(conn ServerCodec) *clientConn {
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, clientContextKey{}, c)
 	ctx = context.WithValue(ctx, peerInfoContextKey{}, conn.peerInfo())
-	handler := newHandler(ctx, conn, c.idgen, c.services, NewExecutionPool(100, 0))
+	handler := newHandler(ctx, conn, c.idgen, c.services, NewExecutionPool(100, 0), c.limiter, c.quota)
 	return &clientConn{conn, handler}
 }
 
@@ -198,17 +200,19 @@ func newClient(initctx context.Context, connect reconnectFunc) (*Client, error)
 	if err != nil {
 		return nil, err
 	}
-	c := initClient(conn, randomIDGenerator(), new(serviceRegistry))
+	c := initClient(conn, randomIDGenerator(), new(serviceRegistry), nil, nil)
 	c.reconnectFunc = connect
 	return c, nil
 }
 
-func initClient(conn ServerCodec, idgen func() ID, services *serviceRegistry) *Client {
+func initClient(conn ServerCodec, idgen func() ID, services *serviceRegistry, limiter *rateLimiter, quota *Quota) *Client {
 	_, isHTTP := conn.(*httpConn)
 	c := &Client{
 		isHTTP:      isHTTP,
 		idgen:       idgen,
 		services:    services,
+		limiter:     limiter,
+		quota:       quota,
 		writeConn:   conn,
 		close:       make(chan struct{}),
 		closing:     make(chan struct{}),
@@ -55,6 +55,8 @@ var (
 	_ Error = new(invalidMessageError)
 	_ Error = new(invalidParamsError)
 	_ Error = new(CustomError)
+	_ Error = new(rateLimitError)
+	_ Error = new(quotaExceededError)
 )
 
 const defaultErrorCode = -32000
@@ -67,6 +69,25 @@ func (e *methodNotFoundError) Error() string {
 	return fmt.Sprintf("the method %s does not exist/is not available", e.method)
 }
 
+type rateLimitError struct{ method string }
+
+func (e *rateLimitError) ErrorCode() int { return -32005 }
+
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded for %s", e.method)
+}
+
+type quotaExceededError struct {
+	method          string
+	cost, remaining float64
+}
+
+func (e *quotaExceededError) ErrorCode() int { return -32007 }
+
+func (e *quotaExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded: %s costs %.2f, %.2f left", e.method, e.cost, e.remaining)
+}
+
 type subscriptionNotFoundError struct{ namespace, subscription string }
 
 func (e *subscriptionNotFoundError) ErrorCode() int { return -32601 }
@@ -18,32 +18,29 @@ type SafePool struct {
 	size    int
 
 	// Skip sending task to execution pool
-	fastPath bool
+	fastPath atomic.Bool
 }
 
 func NewExecutionPool(initialSize int, timeout time.Duration) *SafePool {
 	sp := &SafePool{
-		size:    initialSize,
-		timeout: timeout,
+		executionPool: new(atomic.Pointer[workerpool.WorkerPool]),
+		size:          initialSize,
+		timeout:       timeout,
 	}
 
 	if initialSize == 0 {
-		sp.fastPath = true
+		sp.fastPath.Store(true)
 
 		return sp
 	}
 
-	var ptr atomic.Pointer[workerpool.WorkerPool]
-
-	p := workerpool.New(initialSize)
-	ptr.Store(p)
-	sp.executionPool = &ptr
+	sp.executionPool.Store(workerpool.New(initialSize))
 
 	return sp
 }
 
 func (s *SafePool) Submit(ctx context.Context, fn func() error) (<-chan error, bool) {
-	if s.fastPath {
+	if s.fastPath.Load() {
 		go func() {
 			_ = fn()
 		}()
@@ -51,10 +48,6 @@ func (s *SafePool) Submit(ctx context.Context, fn func() error) (<-chan error, b
 		return nil, true
 	}
 
-	if s.executionPool == nil {
-		return nil, false
-	}
-
 	pool := s.executionPool.Load()
 	if pool == nil {
 		return nil, false
@@ -63,8 +56,25 @@ func (s *SafePool) Submit(ctx context.Context, fn func() error) (<-chan error, b
 	return pool.Submit(ctx, fn, s.Timeout()), true
 }
 
+// ChangeSize resizes the pool, a zero size meaning the tasks aren't sent to a
+// pool anymore. The tasks already submitted keep running on the old pool.
 func (s *SafePool) ChangeSize(n int) {
-	oldPool := s.executionPool.Swap(workerpool.New(n))
+	s.Lock()
+	defer s.Unlock()
+
+	// Switch the fast path on before dropping the pool and off after setting
+	// the new one, so tasks are never submitted to a missing pool
+	var newPool *workerpool.WorkerPool
+	if n > 0 {
+		newPool = workerpool.New(n)
+	} else {
+		s.fastPath.Store(true)
+	}
+
+	oldPool := s.executionPool.Swap(newPool)
+	if n > 0 {
+		s.fastPath.Store(false)
+	}
 
 	if oldPool != nil {
 		go func() {
@@ -72,9 +82,7 @@ func (s *SafePool) ChangeSize(n int) {
 		}()
 	}
 
-	s.Lock()
 	s.size = n
-	s.Unlock()
 }
 
 func (s *SafePool) ChangeTimeout(n time.Duration) {
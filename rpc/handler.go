@@ -66,6 +66,8 @@ type handler struct {
 	serverSubs map[ID]*Subscription
 
 	executionPool *SafePool
+	limiter       *rateLimiter // nil if the calls aren't rate limited
+	quota         *Quota       // nil if the calls aren't accounted
 }
 
 type callProc struct {
@@ -73,7 +75,7 @@ type callProc struct {
 	notifiers []*Notifier
 }
 
-func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *serviceRegistry, pool *SafePool) *handler {
+func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *serviceRegistry, pool *SafePool, limiter *rateLimiter, quota *Quota) *handler {
 	rootCtx, cancelRoot := context.WithCancel(connCtx)
 	h := &handler{
 		reg:            reg,
@@ -87,6 +89,8 @@ func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *
 		serverSubs:     make(map[ID]*Subscription),
 		log:            log.Root(),
 		executionPool:  pool,
+		limiter:        limiter,
+		quota:          quota,
 	}
 	if conn.remoteAddr() != "" {
 		h.log = h.log.New("conn", conn.remoteAddr())
@@ -329,7 +333,23 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 }
 
 // handleCall processes method calls.
-func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage {
+func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) (answer *jsonrpcMessage) {
+	ctx, span := startCallSpan(cp.ctx, msg)
+	defer func() { endCallSpan(span, answer) }()
+
+	// Unsubscribing is never limited, so clients can always clean up
+	if !msg.isUnsubscribe() {
+		info := PeerInfoFromContext(cp.ctx)
+		if h.limiter != nil && !h.limiter.allow(msg.Method, info.RemoteAddr) {
+			rateLimitedRequestGauge.Inc(1)
+			return msg.errorResponse(&rateLimitError{method: msg.Method})
+		}
+		if h.quota != nil {
+			if err := h.quota.charge(msg.Method, msg.Params, info); err != nil {
+				return msg.errorResponse(err)
+			}
+		}
+	}
 	if msg.isSubscribe() {
 		return h.handleSubscribe(cp, msg)
 	}
@@ -347,7 +367,7 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 		return msg.errorResponse(&invalidParamsError{err.Error()})
 	}
 	start := time.Now()
-	answer := h.runMethod(cp.ctx, msg, callb, args)
+	answer = h.runMethod(ctx, msg, callb, args)
 
 	// Collect the statistics for RPC calls if metrics is enabled.
 	// We only care about pure rpc call. Filter out subscription.
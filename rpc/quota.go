@@ -0,0 +1,262 @@
+package rpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+var quotaRejectedMeter = metrics.NewRegisteredMeter("rpc/quota/rejected", nil)
+
+// QuotaConfig are the settings of the cost based quotas of the clients of a
+// server. Each client can spend a budget per period, refilled continuously, on
+// calls costing according to their method.
+type QuotaConfig struct {
+	Budget        float64            // Cost a client can spend per period, the quotas are disabled if zero
+	Period        time.Duration      // Period over which the budget is refilled
+	DefaultCost   float64            // Cost of the methods without a specific one
+	Costs         map[string]float64 // Cost of specific methods
+	LogsBlockCost float64            // Additional cost of eth_getLogs per block of the queried range
+	APIKeyHeader  string             // HTTP header with the API key identifying the clients
+	APIKeys       []string           // API keys of the known clients, the others are identified by IP
+
+	// HeadNumber returns the current block number, resolving the block tags of
+	// the eth_getLogs ranges. The ranges with tags count as one block if nil.
+	HeadNumber func() uint64
+}
+
+// QuotaUsage is the usage of the quota of a client.
+type QuotaUsage struct {
+	Used      float64   `json:"used"`      // Total cost of the served calls
+	Remaining float64   `json:"remaining"` // Budget left for the current period
+	Calls     uint64    `json:"calls"`     // Number of served calls
+	Rejected  uint64    `json:"rejected"`  // Number of calls rejected as over the quota
+	LastSeen  time.Time `json:"lastSeen"`
+}
+
+type quotaClient struct {
+	usage      QuotaUsage
+	lastRefill time.Time
+
+	usedGauge     metrics.GaugeFloat64 // nil for the clients identified by IP
+	rejectedGauge metrics.Gauge        // nil for the clients identified by IP
+}
+
+// Quota tracks the cost of the calls of the clients of one or more servers,
+// rejecting the calls over their budget. Clients are identified by their API
+// key if it's a known one, or by IP, so a client can't get a new budget by
+// making up keys. Only the clients with a known key have their own metrics.
+// Calls without a remote address, like the IPC ones, aren't accounted.
+type Quota struct {
+	config QuotaConfig
+	keys   map[string]string // Client identifiers of the known API keys
+
+	mu        sync.Mutex
+	clients   map[string]*quotaClient
+	lastPrune time.Time
+}
+
+// NewQuota creates the quota tracker of the given config.
+func NewQuota(config QuotaConfig) *Quota {
+	if config.Period <= 0 {
+		config.Period = time.Minute
+	}
+
+	// Identify the keys by a hash, so they don't leak through the metrics
+	keys := make(map[string]string, len(config.APIKeys))
+	for _, key := range config.APIKeys {
+		if key == "" {
+			continue
+		}
+
+		hash := sha256.Sum256([]byte(key))
+		keys[key] = "key-" + hex.EncodeToString(hash[:8])
+	}
+
+	return &Quota{
+		config:  config,
+		keys:    keys,
+		clients: make(map[string]*quotaClient),
+	}
+}
+
+// Usage returns the quota usage of the clients seen recently, keyed by client.
+func (q *Quota) Usage() map[string]QuotaUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	usage := make(map[string]QuotaUsage, len(q.clients))
+
+	for id, client := range q.clients {
+		q.refill(client, now)
+		usage[id] = client.usage
+	}
+
+	return usage
+}
+
+// cost returns the cost of a call of the method with the given parameters.
+func (q *Quota) cost(method string, params json.RawMessage) float64 {
+	cost, ok := q.config.Costs[method]
+	if !ok {
+		cost = q.config.DefaultCost
+	}
+
+	if method == "eth_getLogs" && q.config.LogsBlockCost > 0 {
+		cost += q.config.LogsBlockCost * float64(q.logsRange(params))
+	}
+
+	return cost
+}
+
+// logsRange returns the number of blocks queried by an eth_getLogs call.
+func (q *Quota) logsRange(params json.RawMessage) uint64 {
+	var args []struct {
+		BlockHash *json.RawMessage `json:"blockHash"`
+		FromBlock *BlockNumber     `json:"fromBlock"`
+		ToBlock   *BlockNumber     `json:"toBlock"`
+	}
+	if err := json.Unmarshal(params, &args); err != nil || len(args) == 0 || args[0].BlockHash != nil {
+		return 1
+	}
+
+	resolve := func(number *BlockNumber) (uint64, bool) {
+		switch {
+		case number == nil || *number < 0:
+			if q.config.HeadNumber == nil {
+				return 0, false
+			}
+
+			return q.config.HeadNumber(), true
+		default:
+			return uint64(*number), true
+		}
+	}
+
+	from, okFrom := resolve(args[0].FromBlock)
+	to, okTo := resolve(args[0].ToBlock)
+
+	if !okFrom || !okTo || from > to {
+		return 1
+	}
+
+	return to - from + 1
+}
+
+// charge accounts the cost of a call from the client, failing if the client
+// hasn't got the budget left for it.
+func (q *Quota) charge(method string, params json.RawMessage, info PeerInfo) error {
+	if q.config.Budget <= 0 {
+		return nil
+	}
+
+	id, known := q.clientID(info)
+	if id == "" {
+		return nil
+	}
+
+	cost := q.cost(method, params)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	q.prune(now)
+
+	client, ok := q.clients[id]
+	if !ok {
+		client = &quotaClient{
+			usage:      QuotaUsage{Remaining: q.config.Budget},
+			lastRefill: now,
+		}
+		if known {
+			client.usedGauge = metrics.GetOrRegisterGaugeFloat64(fmt.Sprintf("rpc/quota/clients/%s/used", id), nil)
+			client.rejectedGauge = metrics.GetOrRegisterGauge(fmt.Sprintf("rpc/quota/clients/%s/rejected", id), nil)
+		}
+		q.clients[id] = client
+	}
+
+	q.refill(client, now)
+	client.usage.LastSeen = now
+
+	if cost > client.usage.Remaining {
+		client.usage.Rejected++
+		if client.rejectedGauge != nil {
+			client.rejectedGauge.Inc(1)
+		}
+		quotaRejectedMeter.Mark(1)
+
+		return &quotaExceededError{method: method, cost: cost, remaining: client.usage.Remaining}
+	}
+
+	client.usage.Remaining -= cost
+	client.usage.Used += cost
+	client.usage.Calls++
+	if client.usedGauge != nil {
+		client.usedGauge.Update(client.usage.Used)
+	}
+
+	return nil
+}
+
+// refill adds the budget earned by the client since the last refill.
+func (q *Quota) refill(client *quotaClient, now time.Time) {
+	earned := q.config.Budget * float64(now.Sub(client.lastRefill)) / float64(q.config.Period)
+
+	client.usage.Remaining += earned
+	if client.usage.Remaining > q.config.Budget {
+		client.usage.Remaining = q.config.Budget
+	}
+
+	client.lastRefill = now
+}
+
+// prune drops the clients idle for two periods, with their budget refilled,
+// once per period.
+func (q *Quota) prune(now time.Time) {
+	if now.Sub(q.lastPrune) < q.config.Period {
+		return
+	}
+
+	q.lastPrune = now
+
+	for id, client := range q.clients {
+		if now.Sub(client.usage.LastSeen) > 2*q.config.Period {
+			if client.usedGauge != nil {
+				metrics.Unregister(fmt.Sprintf("rpc/quota/clients/%s/used", id))
+				metrics.Unregister(fmt.Sprintf("rpc/quota/clients/%s/rejected", id))
+			}
+			delete(q.clients, id)
+		}
+	}
+}
+
+// apiKey returns the value of the API key header of a request, if configured.
+func (q *Quota) apiKey(header http.Header) string {
+	if q == nil || q.config.APIKeyHeader == "" {
+		return ""
+	}
+
+	return header.Get(q.config.APIKeyHeader)
+}
+
+// clientID identifies the client of a call by its API key if it's a known one,
+// or by its IP otherwise.
+func (q *Quota) clientID(info PeerInfo) (id string, known bool) {
+	if id, ok := q.keys[info.HTTP.APIKey]; ok {
+		return id, true
+	}
+
+	if ip := remoteIP(info.RemoteAddr); ip != "" {
+		return "ip-" + ip, false
+	}
+
+	return "", false
+}
@@ -0,0 +1,184 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+func TestQuotaCost(t *testing.T) {
+	q := NewQuota(QuotaConfig{
+		Budget:        100,
+		DefaultCost:   1,
+		Costs:         map[string]float64{"debug_traceTransaction": 20},
+		LogsBlockCost: 0.5,
+		HeadNumber:    func() uint64 { return 1000 },
+	})
+
+	tests := []struct {
+		method string
+		params string
+		want   float64
+	}{
+		{"eth_blockNumber", `[]`, 1},
+		{"debug_traceTransaction", `["0x01"]`, 20},
+		{"eth_getLogs", `[{"fromBlock":"0x1","toBlock":"0xa"}]`, 6},
+		{"eth_getLogs", `[{"fromBlock":"0x3e0","toBlock":"latest"}]`, 5.5},
+		{"eth_getLogs", `[{}]`, 1.5},
+		{"eth_getLogs", `[{"blockHash":"0x01"}]`, 1.5},
+		{"eth_getLogs", `[{"fromBlock":"0xa","toBlock":"0x1"}]`, 1.5},
+		{"eth_getLogs", `invalid`, 1.5},
+	}
+	for i, test := range tests {
+		if cost := q.cost(test.method, json.RawMessage(test.params)); cost != test.want {
+			t.Errorf("test %d: wrong cost of %s %s: got %v, want %v", i, test.method, test.params, cost, test.want)
+		}
+	}
+}
+
+func TestQuotaCharge(t *testing.T) {
+	q := NewQuota(QuotaConfig{Budget: 3, Period: time.Hour, DefaultCost: 1, Costs: map[string]float64{"test_heavy": 2}})
+
+	client := PeerInfo{RemoteAddr: "10.0.0.1:1000"}
+	other := PeerInfo{RemoteAddr: "10.0.0.2:1000"}
+
+	if err := q.charge("test_heavy", nil, client); err != nil {
+		t.Fatal("call within the budget rejected:", err)
+	}
+	if err := q.charge("test_heavy", nil, client); err == nil {
+		t.Fatal("call over the budget allowed")
+	}
+	if err := q.charge("test_echo", nil, client); err != nil {
+		t.Fatal("cheaper call within the budget rejected:", err)
+	}
+	if err := q.charge("test_heavy", nil, other); err != nil {
+		t.Fatal("call of another client rejected:", err)
+	}
+	// Calls without a remote address aren't accounted
+	for i := 0; i < 5; i++ {
+		if err := q.charge("test_heavy", nil, PeerInfo{}); err != nil {
+			t.Fatal("call without a remote address rejected:", err)
+		}
+	}
+
+	usage := q.Usage()
+	if len(usage) != 2 {
+		t.Fatalf("wrong number of clients: %v", usage)
+	}
+	if u := usage["ip-10.0.0.1"]; u.Used != 3 || u.Calls != 2 || u.Rejected != 1 || u.Remaining > 0.01 {
+		t.Fatalf("wrong usage of the client: %+v", u)
+	}
+}
+
+func TestQuotaRefill(t *testing.T) {
+	q := NewQuota(QuotaConfig{Budget: 2, Period: 100 * time.Millisecond, DefaultCost: 1})
+	client := PeerInfo{RemoteAddr: "10.0.0.1:1000"}
+
+	for i := 0; i < 2; i++ {
+		if err := q.charge("test_echo", nil, client); err != nil {
+			t.Fatalf("call %d rejected within the budget: %v", i, err)
+		}
+	}
+	if err := q.charge("test_echo", nil, client); err == nil {
+		t.Fatal("call over the budget allowed")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if err := q.charge("test_echo", nil, client); err != nil {
+		t.Fatal("call rejected after the budget refilled:", err)
+	}
+	if u := q.Usage()["ip-10.0.0.1"]; u.Remaining > 2 {
+		t.Fatalf("budget refilled over the limit: %v", u.Remaining)
+	}
+}
+
+func TestQuotaAPIKeys(t *testing.T) {
+	q := NewQuota(QuotaConfig{Budget: 1, Period: time.Hour, DefaultCost: 1, APIKeys: []string{"known"}})
+
+	withKey := func(key string) PeerInfo {
+		info := PeerInfo{RemoteAddr: "10.0.0.3:1000"}
+		info.HTTP.APIKey = key
+		return info
+	}
+
+	if err := q.charge("test_echo", nil, withKey("known")); err != nil {
+		t.Fatal("call with a known key rejected:", err)
+	}
+	if err := q.charge("test_echo", nil, withKey("known")); err == nil {
+		t.Fatal("call with a known key over the budget allowed")
+	}
+	// Unknown keys share the budget of their IP
+	if err := q.charge("test_echo", nil, withKey("unknown")); err != nil {
+		t.Fatal("call with an unknown key rejected:", err)
+	}
+	if err := q.charge("test_echo", nil, withKey("other")); err == nil {
+		t.Fatal("call with another unknown key over the budget of the IP allowed")
+	}
+
+	usage := q.Usage()
+	if len(usage) != 2 {
+		t.Fatalf("wrong number of clients: %v", usage)
+	}
+	id := q.keys["known"]
+	if u := usage[id]; u.Calls != 1 || u.Rejected != 1 {
+		t.Fatalf("wrong usage of the known key: %+v", u)
+	}
+	if u := usage["ip-10.0.0.3"]; u.Calls != 1 || u.Rejected != 1 {
+		t.Fatalf("wrong usage of the IP: %+v", u)
+	}
+	// Only the known keys have their own metrics
+	if metrics.DefaultRegistry.Get("rpc/quota/clients/"+id+"/used") == nil {
+		t.Error("metrics of the known key not registered")
+	}
+	if metrics.DefaultRegistry.Get("rpc/quota/clients/ip-10.0.0.3/used") != nil {
+		t.Error("metrics of the IP registered")
+	}
+}
+
+func TestServerQuotaAPIKey(t *testing.T) {
+	server := newTestServer()
+	defer server.Stop()
+	server.SetQuota(NewQuota(QuotaConfig{Budget: 1, Period: time.Hour, DefaultCost: 1, APIKeyHeader: "X-Api-Key", APIKeys: []string{"first", "second"}}))
+
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	dial := func(key string) *Client {
+		c, err := DialHTTP(ts.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetHeader("X-Api-Key", key)
+		return c
+	}
+	first, second, unknown := dial("first"), dial("second"), dial("unknown")
+	defer first.Close()
+	defer second.Close()
+	defer unknown.Close()
+
+	var result echoResult
+	if err := first.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatal("first call failed:", err)
+	}
+	err := first.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"})
+
+	var rpcErr Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != -32007 {
+		t.Fatalf("wrong error for call over the quota: %v", err)
+	}
+	// Clients with another key have their own budget, even from the same IP
+	if err := second.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatal("call with another key failed:", err)
+	}
+	// Clients with an unknown key are identified by IP
+	if err := unknown.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatal("call with an unknown key failed:", err)
+	}
+	if err := unknown.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"}); !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != -32007 {
+		t.Fatalf("wrong error for call with an unknown key over the quota of the IP: %v", err)
+	}
+}
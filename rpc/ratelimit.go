@@ -0,0 +1,184 @@
+package rpc
+
+import (
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// ipLimiterExpiry is the time after which the limiter of an idle client IP is
+// dropped. It should be long enough for the bucket to refill meanwhile.
+const ipLimiterExpiry = 10 * time.Minute
+
+// RateLimit is the number of calls per second allowed, and the burst of calls
+// allowed on top. A zero rate means no limit. A burst below one, which would
+// reject all the calls, defaults to the calls of one second.
+type RateLimit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// withDefaultBurst returns the limit with its burst defaulted if below one.
+func (r RateLimit) withDefaultBurst() RateLimit {
+	if r.Rate > 0 && r.Burst < 1 {
+		r.Burst = int(math.Max(1, math.Ceil(r.Rate)))
+	}
+
+	return r
+}
+
+// RateLimits are the per method and per client IP rate limits of a server.
+type RateLimits struct {
+	Methods map[string]RateLimit `json:"methods"`
+	IP      RateLimit            `json:"ip"` // Applied to each client IP separately
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiter enforces the rate limits of the calls served by a server, shared
+// by all its connections so they can be changed at runtime.
+type rateLimiter struct {
+	mu        sync.Mutex
+	methods   map[string]*rate.Limiter
+	limits    RateLimits
+	ips       map[string]*ipLimiter
+	lastPrune time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		methods: make(map[string]*rate.Limiter),
+		limits:  RateLimits{Methods: make(map[string]RateLimit)},
+		ips:     make(map[string]*ipLimiter),
+	}
+}
+
+// setMethodLimit sets the rate limit of a method, removing it if the rate is
+// zero.
+func (l *rateLimiter) setMethodLimit(method string, limit RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit.Rate <= 0 {
+		delete(l.methods, method)
+		delete(l.limits.Methods, method)
+
+		return
+	}
+
+	limit = limit.withDefaultBurst()
+
+	l.methods[method] = rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+	l.limits.Methods[method] = limit
+}
+
+// setIPLimit sets the rate limit of each client IP, removing it if the rate is
+// zero. The current usage of the clients is discarded.
+func (l *rateLimiter) setIPLimit(limit RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit.Rate <= 0 {
+		limit = RateLimit{}
+	}
+
+	l.limits.IP = limit.withDefaultBurst()
+	l.ips = make(map[string]*ipLimiter)
+}
+
+// rateLimits returns a copy of the current rate limits.
+func (l *rateLimiter) rateLimits() RateLimits {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limits := RateLimits{
+		Methods: make(map[string]RateLimit, len(l.limits.Methods)),
+		IP:      l.limits.IP,
+	}
+	for method, limit := range l.limits.Methods {
+		limits.Methods[method] = limit
+	}
+
+	return limits
+}
+
+// allow reports whether a call of the method from the remote address is
+// within the rate limits, consuming from both limits if so. Calls without a
+// remote address, like the IPC ones, aren't limited per IP.
+func (l *rateLimiter) allow(method string, remoteAddr string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	var ipLimit *rate.Limiter
+	if ip := remoteIP(remoteAddr); ip != "" && l.limits.IP.Rate > 0 {
+		l.pruneIPs(now)
+
+		entry, ok := l.ips[ip]
+		if !ok {
+			entry = &ipLimiter{limiter: rate.NewLimiter(rate.Limit(l.limits.IP.Rate), l.limits.IP.Burst)}
+			l.ips[ip] = entry
+		}
+
+		entry.lastSeen = now
+		ipLimit = entry.limiter
+	}
+
+	methodLimit := l.methods[method]
+
+	// Reserve from both limits first, so a call rejected by one of them
+	// doesn't consume from the other
+	var reservations []*rate.Reservation
+
+	for _, limiter := range []*rate.Limiter{ipLimit, methodLimit} {
+		if limiter == nil {
+			continue
+		}
+
+		r := limiter.ReserveN(now, 1)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+
+			for _, reserved := range reservations {
+				reserved.CancelAt(now)
+			}
+
+			return false
+		}
+
+		reservations = append(reservations, r)
+	}
+
+	return true
+}
+
+// pruneIPs drops the limiters of the client IPs idle for long, once a minute.
+func (l *rateLimiter) pruneIPs(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+
+	l.lastPrune = now
+
+	for ip, entry := range l.ips {
+		if now.Sub(entry.lastSeen) > ipLimiterExpiry {
+			delete(l.ips, ip)
+		}
+	}
+}
+
+// remoteIP returns the host part of a remote address.
+func remoteIP(remoteAddr string) string {
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+
+	return remoteAddr
+}
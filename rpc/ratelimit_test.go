@@ -0,0 +1,148 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterMethods(t *testing.T) {
+	l := newRateLimiter()
+	l.setMethodLimit("test_echo", RateLimit{Rate: 0.001, Burst: 2})
+
+	for i := 0; i < 2; i++ {
+		if !l.allow("test_echo", "") {
+			t.Fatalf("call %d rejected within the burst", i)
+		}
+	}
+	if l.allow("test_echo", "") {
+		t.Fatal("call over the burst allowed")
+	}
+	if !l.allow("test_rets", "") {
+		t.Fatal("call of an unlimited method rejected")
+	}
+
+	// Removing the limit should allow the calls again
+	l.setMethodLimit("test_echo", RateLimit{})
+	if !l.allow("test_echo", "") {
+		t.Fatal("call rejected after removing the limit")
+	}
+	if limits := l.rateLimits(); len(limits.Methods) != 0 {
+		t.Fatalf("limits not removed: %v", limits.Methods)
+	}
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	l := newRateLimiter()
+	l.setMethodLimit("test_echo", RateLimit{Rate: 2.5})
+	l.setIPLimit(RateLimit{Rate: 0.5, Burst: -1})
+
+	limits := l.rateLimits()
+	if burst := limits.Methods["test_echo"].Burst; burst != 3 {
+		t.Fatalf("method burst mismatch: have %d, want 3", burst)
+	}
+	if limits.IP.Burst != 1 {
+		t.Fatalf("ip burst mismatch: have %d, want 1", limits.IP.Burst)
+	}
+
+	// The calls within the defaulted bursts are allowed
+	if !l.allow("test_echo", "127.0.0.1:1234") {
+		t.Fatal("call rejected within the default burst")
+	}
+	if l.allow("test_rets", "127.0.0.1:1234") {
+		t.Fatal("call over the default ip burst allowed")
+	}
+	for i := 1; i < 3; i++ {
+		if !l.allow("test_echo", "") {
+			t.Fatalf("call %d rejected within the default burst", i)
+		}
+	}
+	if l.allow("test_echo", "") {
+		t.Fatal("call over the default method burst allowed")
+	}
+}
+
+func TestRateLimiterIPs(t *testing.T) {
+	l := newRateLimiter()
+	l.setIPLimit(RateLimit{Rate: 0.001, Burst: 1})
+	l.setMethodLimit("test_echo", RateLimit{Rate: 0.001, Burst: 1})
+
+	if !l.allow("test_echo", "10.0.0.1:1000") {
+		t.Fatal("first call rejected")
+	}
+	// Rejected by the method limit, the IP budget shouldn't be consumed
+	if l.allow("test_echo", "10.0.0.2:1000") {
+		t.Fatal("call over the method limit allowed")
+	}
+	if !l.allow("test_rets", "10.0.0.2:2000") {
+		t.Fatal("call within the IP limit rejected")
+	}
+	if l.allow("test_rets", "10.0.0.1:2000") {
+		t.Fatal("call over the IP limit allowed from another port")
+	}
+	// Calls without a remote address aren't limited per IP
+	for i := 0; i < 3; i++ {
+		if !l.allow("test_rets", "") {
+			t.Fatal("call without a remote address rejected")
+		}
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	server := newTestServer()
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	server.SetMethodRateLimit("test_echo", RateLimit{Rate: 0.001, Burst: 1})
+
+	var result echoResult
+	if err := client.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatal("first call failed:", err)
+	}
+	err := client.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"})
+
+	var rpcErr Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != -32005 {
+		t.Fatalf("wrong error for call over the limit: %v", err)
+	}
+	if limits := server.GetRateLimits(); limits.Methods["test_echo"].Burst != 1 {
+		t.Fatalf("wrong limits reported: %v", limits)
+	}
+}
+
+func TestServerUnregisterName(t *testing.T) {
+	server := newTestServer()
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	nc := make(chan int)
+	sub, err := client.Subscribe(context.Background(), "nftest", nc, "someSubscription", 1, 0)
+	if err != nil {
+		t.Fatal("can't subscribe:", err)
+	}
+	<-nc
+
+	server.UnregisterName("nftest")
+
+	var result int
+	err = client.Call(&result, "nftest_echo", 1)
+
+	var rpcErr Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != -32601 {
+		t.Fatalf("wrong error for unregistered method: %v", err)
+	}
+
+	// The existing subscription should be kept, and still be cancellable
+	select {
+	case err := <-sub.Err():
+		t.Fatal("subscription dropped:", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	sub.Unsubscribe()
+	if err := <-sub.Err(); err != nil {
+		t.Fatal("unsubscribe failed:", err)
+	}
+}
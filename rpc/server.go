@@ -53,6 +53,8 @@ type Server struct {
 
 	BatchLimit    uint64
 	executionPool *SafePool
+	limiter       *rateLimiter
+	quota         *Quota
 }
 
 // NewServer creates a new server instance with no registered handlers.
@@ -62,6 +64,7 @@ func NewServer(executionPoolSize uint64, executionPoolRequesttimeout time.Durati
 		codecs:        mapset.NewSet(),
 		run:           1,
 		executionPool: NewExecutionPool(int(executionPoolSize), executionPoolRequesttimeout),
+		limiter:       newRateLimiter(),
 	}
 
 	// Register the default service providing meta information about the RPC service such
@@ -91,6 +94,29 @@ func (s *Server) GetExecutionPoolSize() int {
 	return s.executionPool.Size()
 }
 
+// SetMethodRateLimit sets the rate limit of the calls of a method, removing it
+// if the rate is zero. The calls over the limit are rejected.
+func (s *Server) SetMethodRateLimit(method string, limit RateLimit) {
+	s.limiter.setMethodLimit(method, limit)
+}
+
+// SetIPRateLimit sets the rate limit of the calls of each client IP, removing
+// it if the rate is zero. The calls over the limit are rejected.
+func (s *Server) SetIPRateLimit(limit RateLimit) {
+	s.limiter.setIPLimit(limit)
+}
+
+// SetQuota sets the tracker of the quotas of the clients, which can be shared
+// between servers. It must be set before serving requests.
+func (s *Server) SetQuota(quota *Quota) {
+	s.quota = quota
+}
+
+// GetRateLimits returns the rate limits of the server.
+func (s *Server) GetRateLimits() RateLimits {
+	return s.limiter.rateLimits()
+}
+
 // RegisterName creates a service for the given receiver type under the given name. When no
 // methods on the given receiver match the criteria to be either a RPC method or a
 // subscription an error is returned. Otherwise a new service is created and added to the
@@ -99,6 +125,13 @@ func (s *Server) RegisterName(name string, receiver interface{}) error {
 	return s.services.registerName(name, receiver)
 }
 
+// UnregisterName removes the service registered under the given name. The calls of its
+// methods fail from then on, on the existing connections too, but the subscriptions
+// already created keep running until they are unsubscribed.
+func (s *Server) UnregisterName(name string) {
+	s.services.unregisterName(name)
+}
+
 // ServeCodec reads incoming requests from codec, calls the appropriate callback and writes
 // the response back using the given codec. It will block until the codec is closed or the
 // server is stopped. In either case the codec is closed.
@@ -116,7 +149,7 @@ func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
 	s.codecs.Add(codec)
 	defer s.codecs.Remove(codec)
 
-	c := initClient(codec, s.idgen, &s.services)
+	c := initClient(codec, s.idgen, &s.services, s.limiter, s.quota)
 	<-codec.closed()
 	c.Close()
 }
@@ -130,7 +163,7 @@ func (s *Server) serveSingleRequest(ctx context.Context, codec ServerCodec) {
 		return
 	}
 
-	h := newHandler(ctx, codec, s.idgen, &s.services, s.executionPool)
+	h := newHandler(ctx, codec, s.idgen, &s.services, s.executionPool, s.limiter, s.quota)
 
 	h.allowSubscribe = false
 	defer h.close(io.EOF, nil)
@@ -214,6 +247,9 @@ type PeerInfo struct {
 		UserAgent string
 		Origin    string
 		Host      string
+		// Value of the API key header identifying the client for the quotas,
+		// if configured.
+		APIKey string
 	}
 }
 
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ethereum/go-ethereum/common/tracing"
+)
+
+// startCallSpan starts the span of a method call, set in the returned context so
+// the spans of the processing of the call are its children. It's a root span,
+// unless the HTTP request of the call carried a trace context.
+func startCallSpan(ctx context.Context, msg *jsonrpcMessage) (context.Context, trace.Span) {
+	ctx = tracing.WithTracer(ctx, otel.GetTracerProvider().Tracer("rpc"))
+	ctx, span := tracing.StartSpan(ctx, "rpc."+msg.Method)
+
+	tracing.SetAttributes(
+		span,
+		attribute.String("rpc.system", "jsonrpc"),
+		attribute.String("rpc.method", msg.Method),
+		attribute.String("rpc.transport", PeerInfoFromContext(ctx).Transport),
+	)
+
+	return ctx, span
+}
+
+// endCallSpan ends the span of a method call, recording its error if any.
+func endCallSpan(span trace.Span, answer *jsonrpcMessage) {
+	if answer != nil && answer.Error != nil {
+		tracing.RecordError(span, answer.Error)
+	}
+
+	tracing.EndSpan(span)
+}
+
+// extractTraceContext returns the context with the trace context propagated in
+// the headers of an HTTP request, if any.
+func extractTraceContext(ctx context.Context, header http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+}
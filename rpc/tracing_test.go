@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestCallSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	}()
+
+	server := newTestServer()
+	defer server.Stop()
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	client, err := DialHTTP(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// The caller's trace context should become the parent of the call span
+	parent, parentSpan := provider.Tracer("test").Start(context.Background(), "caller")
+	headers := propagation.HeaderCarrier{}
+	otel.GetTextMapPropagator().Inject(parent, headers)
+	client.SetHeader("traceparent", headers.Get("traceparent"))
+
+	var result echoResult
+	if err := client.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Call(nil, "test_returnError"); err == nil {
+		t.Fatal("expected error from test_returnError")
+	}
+	parentSpan.End()
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	echo, ok := spans["rpc.test_echo"]
+	if !ok {
+		t.Fatalf("no span for the call, got %v", spans)
+	}
+	if echo.Parent().TraceID() != parentSpan.SpanContext().TraceID() || echo.Parent().SpanID() != parentSpan.SpanContext().SpanID() {
+		t.Fatalf("wrong parent of the call span: %v", echo.Parent())
+	}
+	if echo.Status().Code == codes.Error {
+		t.Fatalf("successful call span has error status: %v", echo.Status())
+	}
+
+	failed, ok := spans["rpc.test_returnError"]
+	if !ok {
+		t.Fatalf("no span for the failed call, got %v", spans)
+	}
+	if failed.Status().Code != codes.Error {
+		t.Fatalf("failed call span has status %v, want error", failed.Status())
+	}
+}
+
+func TestCallSpansInProc(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	prevProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(prevProvider)
+
+	server := newTestServer()
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	var result echoResult
+	if err := client.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ended := recorder.Ended()
+	if len(ended) != 1 || ended[0].Name() != "rpc.test_echo" {
+		t.Fatalf("wrong spans recorded: %v", ended)
+	}
+	if ended[0].Parent().IsValid() {
+		t.Fatalf("call span without trace context isn't a root span: %v", ended[0].Parent())
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"path"
 	"strings"
 	"testing"
@@ -30,7 +31,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/signer/core"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
@@ -232,6 +235,57 @@ func TestSignData(t *testing.T) {
 	}
 }
 
+func TestSignBorHeader(t *testing.T) {
+	api, control := setup(t)
+	createAccount(control, api, t)
+	control.approveCh <- "A"
+	list, err := api.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := common.NewMixedcaseAddress(list[0])
+
+	header := &types.Header{
+		ParentHash: common.HexToHash("0x01"),
+		Number:     big.NewInt(64),
+		GasLimit:   30_000_000,
+		Time:       1700000000,
+		Extra:      make([]byte, 32),
+	}
+	borRlp, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	control.approveCh <- "Y"
+	control.inputCh <- "a_long_password"
+	signature, err := api.SignData(context.Background(), apitypes.ApplicationBor.Mime, a, hexutil.Encode(borRlp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signature) != 65 {
+		t.Fatalf("Expected 65 byte signature (got %d bytes)", len(signature))
+	}
+	// Bor uses V on the form 0 or 1, over the hash of the header as sent
+	if signature[64] > 1 {
+		t.Errorf("Expected V on the form 0 or 1, got %d", signature[64])
+	}
+	pubkey, err := crypto.SigToPub(crypto.Keccak256(borRlp), signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubkey); signer != a.Address() {
+		t.Errorf("Expected signature by %v, got %v", a.Address(), signer)
+	}
+	// The data must be an hex-encoded header
+	if _, err := api.SignData(context.Background(), apitypes.ApplicationBor.Mime, a, 42); err == nil {
+		t.Error("Expected error for non-string input")
+	}
+	if _, err := api.SignData(context.Background(), apitypes.ApplicationBor.Mime, a, hexutil.Encode([]byte("not a header"))); err == nil {
+		t.Error("Expected error for invalid header rlp")
+	}
+}
+
 func TestDomainChainId(t *testing.T) {
 	withoutChainID := apitypes.TypedData{
 		Types: apitypes.Types{
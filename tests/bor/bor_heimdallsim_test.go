@@ -0,0 +1,116 @@
+//go:build integration
+
+package bor
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallsim"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TestHeimdallSimulator runs the chain against the heimdall simulator across a
+// span boundary. The spans are fetched from the simulator and committed by the
+// chain, and the state-sync events injected in the simulator are committed at
+// the start of a sprint.
+func TestHeimdallSimulator(t *testing.T) {
+	init := buildEthereumInstance(t, rawdb.NewMemoryDatabase())
+	chain := init.ethereum.BlockChain()
+	borConfig := init.genesis.Config.Bor
+	_bor := init.ethereum.Engine().(*bor.Bor)
+
+	defer _bor.Close()
+
+	// The genesis span of the test genesis is produced by addr, the next ones
+	// are produced in turn by addr2 and addr
+	sim, err := heimdallsim.New(heimdallsim.Config{
+		ChainID:       init.genesis.Config.ChainID.String(),
+		Validators:    []*valset.Validator{valset.NewValidator(addr, 10), valset.NewValidator(addr2, 10)},
+		ZeroSpanEnd:   spanSize - 1,
+		SpanLength:    spanSize,
+		ProducerCount: 1,
+	}, nil)
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(sim.Handler())
+	defer srv.Close()
+
+	// The heimdall client is closed along with bor
+	_bor.SetHeimdallClient(heimdall.NewHeimdallClient(srv.URL))
+
+	span1, span2 := sim.Span(1), sim.Span(2)
+	producers := []*valset.Validator{valset.NewValidator(addr, 10)}
+	producers1 := []*valset.Validator{&span1.SelectedProducers[0]}
+
+	require.Equal(t, addr2, producers1[0].Address)
+	require.Equal(t, addr, span2.SelectedProducers[0].Address)
+
+	events := []*clerk.EventRecordWithTime{
+		sim.AddStateSyncEvent(common.HexToAddress("0x1"), []byte{0x01}),
+		sim.AddStateSyncEvent(common.HexToAddress("0x2"), []byte{0x02}),
+	}
+
+	// The blocks of the genesis span are older than the events, which aren't
+	// committed yet. The first span is committed in the last sprint.
+	block := init.genesis.ToBlock(init.ethereum.ChainDb())
+
+	for block.NumberU64() < span1.StartBlock-1 {
+		validators := producers
+		if block.NumberU64()+1 == span1.StartBlock-1 {
+			validators = producers1
+		}
+
+		block = buildNextBlock(t, _bor, chain, block, nil, borConfig, nil, validators)
+		insertNewBlock(t, chain, block)
+
+		require.Empty(t, chain.GetStateSync(), "block %d", block.NumberU64())
+	}
+
+	validators, err := _bor.GetCurrentValidators(context.Background(), block.Hash(), span1.StartBlock)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, addr2, validators[0].Address)
+
+	// The first span starts after the events, which are committed at the start
+	// of the next sprint along with the second span
+	_bor.Authorize(addr2, func(account accounts.Account, s string, data []byte) ([]byte, error) {
+		return crypto.Sign(crypto.Keccak256(data), key2)
+	})
+
+	block = buildNextBlock(t, _bor, chain, block, nil, borConfig, nil, producers1, func(header *types.Header) {
+		header.Time = uint64(events[len(events)-1].Time.Unix()) + 1
+	})
+	insertNewBlock(t, chain, block)
+
+	require.Empty(t, chain.GetStateSync())
+
+	for i := uint64(1); i < sprintSize; i++ {
+		block = buildNextBlock(t, _bor, chain, block, nil, borConfig, nil, producers1)
+		insertNewBlock(t, chain, block)
+	}
+
+	block = buildNextBlock(t, _bor, chain, block, nil, borConfig, nil, producers1)
+	validateStateSyncEvents(t, events, chain.GetStateSync())
+	insertNewBlock(t, chain, block)
+
+	lastStateID, err := _bor.GenesisContractsClient.LastStateId(block.NumberU64())
+	require.NoError(t, err)
+	require.Equal(t, events[len(events)-1].ID, lastStateID.Uint64())
+
+	validators, err = _bor.GetCurrentValidators(context.Background(), block.Hash(), span2.StartBlock)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, addr, validators[0].Address)
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"math/big"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -35,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
@@ -416,6 +418,61 @@ func TestInsertingSpanSizeBlocks(t *testing.T) {
 	}
 }
 
+func TestRecordSlot(t *testing.T) {
+	init := buildEthereumInstance(t, rawdb.NewMemoryDatabase())
+	chain := init.ethereum.BlockChain()
+	engine := init.ethereum.Engine()
+	_bor := engine.(*bor.Bor)
+
+	defer _bor.Close()
+
+	_, currentSpan := loadSpanFromFile(t)
+
+	h, ctrl := getMockedHeimdallClient(t, currentSpan)
+	defer ctrl.Finish()
+
+	h.EXPECT().Close().AnyTimes()
+	h.EXPECT().FetchCheckpointCount(gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+	_bor.SetHeimdallClient(h)
+
+	block := init.genesis.ToBlock(init.ethereum.ChainDb())
+	currentValidators := []*valset.Validator{valset.NewValidator(addr, 10)}
+
+	_bor.SetSpanner(getMockedSpanner(t, currentValidators))
+
+	var headers []*types.Header
+
+	for i := uint64(1); i <= sprintSize; i++ {
+		block = buildNextBlock(t, _bor, chain, block, nil, init.genesis.Config.Bor, nil, currentValidators)
+		insertNewBlock(t, chain, block)
+
+		headers = append(headers, block.Header())
+	}
+
+	// the block production metrics are only updated with metrics enabled
+	enabled := metrics.Enabled
+	metrics.Enabled = true
+
+	defer func() { metrics.Enabled = enabled }()
+
+	produced := metrics.GetOrRegisterCounter("bor/validators/"+strings.ToLower(addr.Hex())+"/produced", nil)
+	start := produced.Count()
+
+	// verifying the headers again doesn't count them as produced
+	for _, header := range headers {
+		require.NoError(t, engine.VerifyHeader(chain, header, true))
+	}
+
+	require.Equal(t, start, produced.Count())
+
+	for _, header := range headers {
+		require.NoError(t, _bor.RecordSlot(chain, header))
+	}
+
+	require.Equal(t, start+int64(len(headers)), produced.Count())
+}
+
 func TestFetchStateSyncEvents(t *testing.T) {
 	init := buildEthereumInstance(t, rawdb.NewMemoryDatabase())
 	chain := init.ethereum.BlockChain()